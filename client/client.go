@@ -0,0 +1,246 @@
+// Package client is a typed Go client for the project-core internal API, for
+// the specialized modules that build on projects and companies.
+//
+// Every method takes the calling user's ID, which the service uses for its
+// permission checks. Reads, updates and deletes are retried with exponential
+// backoff on network errors and 429, 502, 503 and 504 responses; creates and
+// other POST calls are never retried because they are not idempotent. Failed
+// calls return an *APIError that matches the sentinel errors with errors.Is:
+//
+//	project, err := c.GetProject(ctx, 42, userID)
+//	if errors.Is(err, client.ErrForbidden) {
+//		...
+//	}
+//
+// Package clienttest provides an in-memory fake of the API for tests.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Defaults for Options left at their zero value
+const (
+	DefaultTimeout    = 30 * time.Second
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 100 * time.Millisecond
+	DefaultMaxBackoff = 2 * time.Second
+)
+
+// Options configures a Client. Zero values use the defaults above.
+type Options struct {
+	HTTPClient *http.Client // Defaults to a client with DefaultTimeout
+	MaxRetries int          // Retries after the first attempt; negative disables retries
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// Client calls the project-core internal API
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+// New creates a client for the service at baseURL, e.g. "http://project-core:8001"
+func New(baseURL string, options Options) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/") + "/api/internal",
+		httpClient: options.HTTPClient,
+		maxRetries: options.MaxRetries,
+		minBackoff: options.MinBackoff,
+		maxBackoff: options.MaxBackoff,
+	}
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	switch {
+	case c.maxRetries == 0:
+		c.maxRetries = DefaultMaxRetries
+	case c.maxRetries < 0:
+		c.maxRetries = 0
+	}
+	if c.minBackoff <= 0 {
+		c.minBackoff = DefaultMinBackoff
+	}
+	if c.maxBackoff <= 0 {
+		c.maxBackoff = DefaultMaxBackoff
+	}
+	return c
+}
+
+// Private helper methods
+
+// call describes one request; query and body are optional
+type call struct {
+	method string
+	path   string
+	query  url.Values
+	userID string // sent as X-User-ID
+	body   any
+
+	// noRetry marks a PUT or DELETE that is not safe to repeat
+	noRetry bool
+}
+
+// envelope is the service's success response
+type envelope struct {
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// list is the service's standard list payload
+type list[T any] struct {
+	Data []T `json:"data"`
+}
+
+// do sends the request and decodes the "data" of the response into out, which
+// may be nil
+func (c *Client) do(ctx context.Context, req call, out any) error {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return err
+		}
+	}
+
+	contentType := ""
+	if body != nil {
+		contentType = "application/json"
+	}
+	resp, err := c.send(ctx, req, func() io.Reader {
+		if body == nil {
+			return nil
+		}
+		return bytes.NewReader(body)
+	}, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decode(resp, out)
+}
+
+// send performs the request, retrying idempotent methods. newBody is called
+// once per attempt so every attempt gets a fresh reader.
+func (c *Client) send(ctx context.Context, req call, newBody func() io.Reader, contentType string) (*http.Response, error) {
+	target := c.baseURL + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	retries := 0
+	if isIdempotent(req.method) && !req.noRetry {
+		retries = c.maxRetries
+	}
+	for attempt := 0; ; attempt++ {
+		httpReq, err := http.NewRequestWithContext(ctx, req.method, target, newBody())
+		if err != nil {
+			return nil, err
+		}
+		httpReq.Header.Set("Accept", "application/json")
+		if contentType != "" {
+			httpReq.Header.Set("Content-Type", contentType)
+		}
+		if req.userID != "" {
+			httpReq.Header.Set("X-User-ID", req.userID)
+		}
+
+		resp, err := c.httpClient.Do(httpReq)
+		if attempt >= retries || !shouldRetry(resp, err) || ctx.Err() != nil {
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(c.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff doubles per attempt up to maxBackoff, with full jitter so callers
+// retrying together spread out
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.minBackoff << attempt
+	if delay <= 0 || delay > c.maxBackoff {
+		delay = c.maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// decode turns an error status into an *APIError and otherwise unwraps the
+// success envelope into out
+func decode(resp *http.Response, out any) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return newAPIError(resp.StatusCode, data)
+	}
+	if out == nil {
+		return nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if err := json.Unmarshal(env.Data, out); err != nil {
+		return fmt.Errorf("decode response data: %w", err)
+	}
+	return nil
+}
+
+func userQuery(userID string) url.Values {
+	return url.Values{"userId": {userID}}
+}
+
+func projectPath(id uint, rest ...string) string {
+	return fmt.Sprintf("/projects/%d", id) + strings.Join(rest, "")
+}
+
+func companyPath(id string, rest ...string) string {
+	return "/companies/" + url.PathEscape(id) + strings.Join(rest, "")
+}
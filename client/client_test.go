@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient starts a server answering with the given statuses in order,
+// then 200, and returns a client for it with short backoffs
+func newTestClient(t *testing.T, options Options, statuses ...int) (*Client, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		if call <= len(statuses) {
+			w.WriteHeader(statuses[call-1])
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"message": "ok", "data": {"id": 1, "title": "Launch"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	options.HTTPClient = server.Client()
+	if options.MinBackoff == 0 {
+		options.MinBackoff = time.Millisecond
+	}
+	if options.MaxBackoff == 0 {
+		options.MaxBackoff = 5 * time.Millisecond
+	}
+	return New(server.URL, options), &calls
+}
+
+func TestRetriesIdempotentRequests(t *testing.T) {
+	c, calls := newTestClient(t, Options{}, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusTooManyRequests)
+
+	project, err := c.GetProject(context.Background(), 1, "user-1")
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if project.Title != "Launch" {
+		t.Errorf("title = %q, want %q", project.Title, "Launch")
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("calls = %d, want 4", got)
+	}
+}
+
+func TestStopsAfterMaxRetries(t *testing.T) {
+	c, calls := newTestClient(t, Options{MaxRetries: 2}, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+	err := c.DeleteProject(context.Background(), 1, "user-1")
+	if !errors.Is(err, ErrServiceUnavailable) {
+		t.Fatalf("err = %v, want ErrServiceUnavailable", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestNegativeMaxRetriesDisablesRetries(t *testing.T) {
+	c, calls := newTestClient(t, Options{MaxRetries: -1}, http.StatusServiceUnavailable)
+
+	if _, err := c.GetProject(context.Background(), 1, "user-1"); !errors.Is(err, ErrServiceUnavailable) {
+		t.Fatalf("err = %v, want ErrServiceUnavailable", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestDoesNotRetryUnsafeRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		send func(c *Client) error
+	}{
+		{"POST", func(c *Client) error {
+			_, err := c.CreateProject(context.Background(), CreateProjectInput{Title: "Launch", OwnerID: "user-1"})
+			return err
+		}},
+		{"noRetry PUT", func(c *Client) error {
+			salary := 50000.0
+			_, err := c.SetMemberCompensation(context.Background(), "acme", "user-2", Compensation{Salary: &salary, Currency: "USD"}, "user-1")
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, calls := newTestClient(t, Options{}, http.StatusServiceUnavailable)
+
+			if err := tt.send(c); !errors.Is(err, ErrServiceUnavailable) {
+				t.Fatalf("err = %v, want ErrServiceUnavailable", err)
+			}
+			if got := calls.Load(); got != 1 {
+				t.Errorf("calls = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestDoesNotRetryOtherStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			c, calls := newTestClient(t, Options{}, status)
+
+			if _, err := c.GetProject(context.Background(), 1, "user-1"); err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := calls.Load(); got != 1 {
+				t.Errorf("calls = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestRetriesNetworkErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		w.Write([]byte(`{"message": "ok", "data": {"id": 1}}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL, Options{HTTPClient: server.Client(), MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})
+	if _, err := c.GetProject(context.Background(), 1, "user-1"); err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestCanceledContextStopsRetries(t *testing.T) {
+	c, calls := newTestClient(t, Options{MinBackoff: time.Hour, MaxBackoff: time.Hour}, http.StatusServiceUnavailable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := c.GetProject(ctx, 1, "user-1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %v, want the backoff to stop on cancel", elapsed)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := New("http://localhost", Options{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second})
+
+	// Doubling from 100ms passes the 1s cap on the fifth attempt; later
+	// attempts, including ones where the shift overflows, stay at the cap
+	limits := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond}
+	for attempt := 0; attempt < 70; attempt++ {
+		limit := time.Second
+		if attempt < len(limits) {
+			limit = limits[attempt]
+		}
+		for i := 0; i < 100; i++ {
+			if delay := c.backoff(attempt); delay <= 0 || delay > limit {
+				t.Fatalf("backoff(%d) = %v, want in (0, %v]", attempt, delay, limit)
+			}
+		}
+	}
+}
+
+func TestNewDefaults(t *testing.T) {
+	c := New("http://project-core:8001/", Options{})
+
+	if c.baseURL != "http://project-core:8001/api/internal" {
+		t.Errorf("baseURL = %q", c.baseURL)
+	}
+	if c.maxRetries != DefaultMaxRetries || c.minBackoff != DefaultMinBackoff || c.maxBackoff != DefaultMaxBackoff {
+		t.Errorf("retries = %d, backoff = %v..%v, want the defaults", c.maxRetries, c.minBackoff, c.maxBackoff)
+	}
+	if c.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("timeout = %v, want %v", c.httpClient.Timeout, DefaultTimeout)
+	}
+}
@@ -0,0 +1,626 @@
+package clienttest
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/client"
+	companiesService "github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+func (s *Server) createCompany(c *gin.Context) {
+	var req struct {
+		ID      string `json:"id" binding:"required"`
+		Name    string `json:"name" binding:"required"`
+		Type    string `json:"type"`
+		OwnerID string `json:"ownerId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	if _, ok := s.companies[req.ID]; ok {
+		responses.InternalError(c, "duplicate key value violates unique constraint")
+		return
+	}
+
+	company := &client.Company{ID: req.ID, Name: req.Name, Type: req.Type, OwnerID: req.OwnerID}
+	s.companies[company.ID] = company
+
+	now := time.Now()
+	s.companyMembers[company.ID] = []*client.CompanyMember{{
+		ID:        s.newID(),
+		CompanyID: company.ID,
+		UserID:    company.OwnerID,
+		Role:      companiesService.OwnerRole,
+		Status:    "active",
+		JoinedAt:  &now,
+		InvitedAt: now,
+		InvitedBy: company.OwnerID,
+	}}
+
+	if template, ok := s.templates.Get(company.Type); ok {
+		for _, role := range template.Roles {
+			s.companyRoles[company.ID] = append(s.companyRoles[company.ID], client.CompanyRole{
+				ID:          s.newID(),
+				CompanyID:   company.ID,
+				Name:        role.Name,
+				Permissions: role.Permissions,
+			})
+		}
+		for _, starter := range template.StarterProjects {
+			project := &client.Project{
+				ID:          s.newID(),
+				Title:       starter.Title,
+				Description: starter.Description,
+				Status:      "active",
+				OwnerID:     company.OwnerID,
+				CompanyID:   &company.ID,
+				StartDate:   &now,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}
+			if starter.DurationDays != nil {
+				endDate := now.AddDate(0, 0, *starter.DurationDays)
+				project.EndDate = &endDate
+			}
+			s.projects[project.ID] = project
+		}
+	}
+
+	responses.Created(c, "Company created successfully", company)
+}
+
+func (s *Server) getCompany(c *gin.Context) {
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanAccessCompany(company.ID, userID) {
+		responses.Forbidden(c, "user cannot access this company")
+		return
+	}
+
+	responses.Success(c, "Company retrieved successfully", company)
+}
+
+func (s *Server) updateCompany(c *gin.Context) {
+	var req struct {
+		Name   string `json:"name"`
+		Type   string `json:"type"`
+		UserID string `json:"userId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanUpdateCompany(company, req.UserID) {
+		responses.Forbidden(c, "user cannot update this company")
+		return
+	}
+
+	if req.Name != "" {
+		company.Name = req.Name
+	}
+	if req.Type != "" {
+		company.Type = req.Type
+	}
+
+	responses.Success(c, "Company updated successfully", company)
+}
+
+func (s *Server) deleteCompany(c *gin.Context) {
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if company.OwnerID != userID {
+		responses.Forbidden(c, "only company owner can delete company")
+		return
+	}
+
+	delete(s.companies, company.ID)
+	delete(s.companyMembers, company.ID)
+	delete(s.companyRoles, company.ID)
+	s.rates = deleteRates(s.rates, company.ID, "")
+
+	responses.Success(c, "Company deleted successfully", nil)
+}
+
+func (s *Server) getUserCompanies(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	companies := []client.Company{}
+	for _, company := range s.companies {
+		if s.userCanAccessCompany(company.ID, userID) {
+			companies = append(companies, *company)
+		}
+	}
+	sort.Slice(companies, func(i, j int) bool { return companies[i].ID < companies[j].ID })
+
+	responses.Success(c, "Companies retrieved successfully", newList(companies))
+}
+
+func (s *Server) getCompanyTemplates(c *gin.Context) {
+	responses.Success(c, "Company templates retrieved successfully", gin.H{
+		"templates": s.templates.List(),
+	})
+}
+
+func (s *Server) getCompanyRoles(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	companyID := c.Param("id")
+	if !s.userCanAccessCompany(companyID, userID) {
+		responses.Forbidden(c, "user cannot access this company")
+		return
+	}
+
+	roles := append([]client.CompanyRole{}, s.roles(companyID)...)
+	responses.Success(c, "Roles retrieved successfully", gin.H{
+		"roles": roles,
+	})
+}
+
+// Company members
+
+func (s *Server) getCompanyMembers(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanAccessCompany(company.ID, userID) {
+		responses.Forbidden(c, "user cannot access this company")
+		return
+	}
+
+	// Compensation is only visible to finance/admin roles, and to each member for themselves
+	canSeeRates := s.userCanManageCompensation(company, userID)
+	members := make([]client.CompanyMember, 0, len(s.companyMembers[company.ID]))
+	for _, member := range s.companyMembers[company.ID] {
+		view := *member
+		if rate := s.currentRate(company.ID, member.UserID); rate != nil {
+			view.Salary, view.HourlyRate, view.Currency = rate.Salary, rate.HourlyRate, rate.Currency
+		}
+		if !canSeeRates && member.UserID != userID {
+			view.Salary, view.HourlyRate, view.Currency = nil, nil, ""
+		}
+		members = append(members, view)
+	}
+
+	responses.Success(c, "Members retrieved successfully", newList(members))
+}
+
+func (s *Server) addCompanyMember(c *gin.Context) {
+	var req struct {
+		UserID string `json:"userId" binding:"required"`
+		Role   string `json:"role" binding:"required"`
+		client.Compensation
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanManageCompanyMembers(company, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot add members to this company")
+		return
+	}
+	if !s.validRole(company.ID, req.Role) {
+		responses.BadRequest(c, "invalid role")
+		return
+	}
+	if !s.canAssignRole(company, req.Role, req.RequestingUserID) {
+		responses.Forbidden(c, "only owners and admins can grant or revoke admin")
+		return
+	}
+	if s.companyMember(company.ID, req.UserID) != nil {
+		responses.Conflict(c, "user is already a member of this company")
+		return
+	}
+
+	now := time.Now()
+	member := &client.CompanyMember{
+		ID:        s.newID(),
+		CompanyID: company.ID,
+		UserID:    req.UserID,
+		Role:      req.Role,
+		Status:    "active",
+		JoinedAt:  &now,
+		InvitedAt: now,
+		InvitedBy: req.RequestingUserID,
+	}
+
+	hasCompensation := req.Salary != nil || req.HourlyRate != nil || req.Currency != "" || req.EffectiveFrom != nil
+	if hasCompensation {
+		if !s.userCanManageCompensation(company, req.RequestingUserID) {
+			responses.Forbidden(c, "user cannot manage compensation for this company")
+			return
+		}
+		rate, message := s.newRate(member, req.Compensation, req.RequestingUserID)
+		if rate == nil {
+			responses.BadRequest(c, message)
+			return
+		}
+		s.rates = append(s.rates, *rate)
+		if current := s.currentRate(company.ID, member.UserID); current != nil {
+			member.Salary, member.HourlyRate, member.Currency = current.Salary, current.HourlyRate, current.Currency
+		}
+	}
+	s.companyMembers[company.ID] = append(s.companyMembers[company.ID], member)
+
+	responses.Created(c, "Member added successfully", member)
+}
+
+func (s *Server) removeCompanyMember(c *gin.Context) {
+	requestingUserID := c.GetHeader("X-User-ID")
+	if requestingUserID == "" {
+		responses.BadRequest(c, "Requesting User ID required")
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	userID := c.Param("userId")
+	if !s.userCanManageCompanyMembers(company, requestingUserID) && userID != requestingUserID {
+		responses.Forbidden(c, "user cannot remove members from this company")
+		return
+	}
+	if company.OwnerID == userID {
+		responses.Forbidden(c, "cannot remove company owner")
+		return
+	}
+
+	members := s.companyMembers[company.ID]
+	for i, member := range members {
+		if member.UserID == userID {
+			s.companyMembers[company.ID] = append(members[:i], members[i+1:]...)
+			break
+		}
+	}
+	s.rates = deleteRates(s.rates, company.ID, userID)
+
+	responses.Success(c, "Member removed successfully", nil)
+}
+
+func (s *Server) updateCompanyMemberRole(c *gin.Context) {
+	var req struct {
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+		Role             string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanManageCompanyMembers(company, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot update members of this company")
+		return
+	}
+	userID := c.Param("userId")
+	if userID == req.RequestingUserID {
+		responses.Forbidden(c, "cannot change your own role")
+		return
+	}
+	if !s.validRole(company.ID, req.Role) {
+		responses.BadRequest(c, "invalid role")
+		return
+	}
+	if company.OwnerID == userID {
+		responses.Forbidden(c, "cannot change company owner role")
+		return
+	}
+	member := s.companyMember(company.ID, userID)
+	if member == nil {
+		responses.NotFound(c, "user is not a member of this company")
+		return
+	}
+
+	if member.Role != req.Role &&
+		(!s.canAssignRole(company, req.Role, req.RequestingUserID) || !s.canAssignRole(company, member.Role, req.RequestingUserID)) {
+		responses.Forbidden(c, "only owners and admins can grant or revoke admin")
+		return
+	}
+
+	member.Role = req.Role
+	responses.Success(c, "Member role updated successfully", s.memberView(company, member, req.RequestingUserID))
+}
+
+// Invitations
+
+func (s *Server) inviteCompanyMember(c *gin.Context) {
+	var req struct {
+		UserID           string `json:"userId" binding:"required"`
+		Role             string `json:"role" binding:"required"`
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanManageCompanyMembers(company, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot add members to this company")
+		return
+	}
+	if !s.validRole(company.ID, req.Role) {
+		responses.BadRequest(c, "invalid role")
+		return
+	}
+	if !s.canAssignRole(company, req.Role, req.RequestingUserID) {
+		responses.Forbidden(c, "only owners and admins can grant or revoke admin")
+		return
+	}
+	if s.companyMember(company.ID, req.UserID) != nil {
+		responses.Conflict(c, "user is already a member of this company")
+		return
+	}
+
+	member := &client.CompanyMember{
+		ID:        s.newID(),
+		CompanyID: company.ID,
+		UserID:    req.UserID,
+		Role:      req.Role,
+		Status:    "invited",
+		InvitedAt: time.Now(),
+		InvitedBy: req.RequestingUserID,
+	}
+	s.companyMembers[company.ID] = append(s.companyMembers[company.ID], member)
+
+	responses.Created(c, "Invitation sent successfully", member)
+}
+
+func (s *Server) acceptInvitation(c *gin.Context) {
+	var req struct {
+		UserID string `json:"userId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member := s.companyMember(c.Param("id"), req.UserID)
+	if member == nil || member.Status != "invited" {
+		responses.NotFound(c, "invitation not found")
+		return
+	}
+
+	now := time.Now()
+	member.Status = "active"
+	member.JoinedAt = &now
+	responses.Success(c, "Invitation accepted successfully", member)
+}
+
+func (s *Server) getUserInvitations(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	invitations := []client.CompanyMember{}
+	for _, members := range s.companyMembers {
+		for _, member := range members {
+			if member.UserID == userID && member.Status == "invited" {
+				invitations = append(invitations, *member)
+			}
+		}
+	}
+	sort.Slice(invitations, func(i, j int) bool { return invitations[i].ID < invitations[j].ID })
+
+	responses.Success(c, "Invitations retrieved successfully", newList(invitations))
+}
+
+// Member compensation
+
+func (s *Server) setMemberCompensation(c *gin.Context) {
+	var req struct {
+		client.Compensation
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	if !s.userCanManageCompensation(company, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot manage compensation for this company")
+		return
+	}
+	member := s.companyMember(company.ID, c.Param("userId"))
+	if member == nil {
+		responses.NotFound(c, "user is not a member of this company")
+		return
+	}
+
+	rate, message := s.newRate(member, req.Compensation, req.RequestingUserID)
+	if rate == nil {
+		responses.BadRequest(c, message)
+		return
+	}
+	s.rates = append(s.rates, *rate)
+	if current := s.currentRate(company.ID, member.UserID); current != nil {
+		member.Salary, member.HourlyRate, member.Currency = current.Salary, current.HourlyRate, current.Currency
+	}
+
+	responses.Created(c, "Compensation updated successfully", rate)
+}
+
+func (s *Server) getMemberCompensationHistory(c *gin.Context) {
+	requestingUserID := requestUserID(c)
+	if requestingUserID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	company := s.findCompany(c)
+	if company == nil {
+		return
+	}
+	// Members can always see their own history
+	userID := c.Param("userId")
+	if userID != requestingUserID && !s.userCanManageCompensation(company, requestingUserID) {
+		responses.Forbidden(c, "user cannot view compensation for this company")
+		return
+	}
+
+	rates := []client.CompensationRate{}
+	for _, rate := range s.rates {
+		if rate.CompanyID == company.ID && rate.UserID == userID {
+			rates = append(rates, rate)
+		}
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		if !rates[i].EffectiveFrom.Equal(rates[j].EffectiveFrom) {
+			return rates[i].EffectiveFrom.After(rates[j].EffectiveFrom)
+		}
+		return rates[i].ID > rates[j].ID
+	})
+
+	responses.Success(c, "Compensation history retrieved successfully", newList(rates))
+}
+
+// Private helper methods
+
+// findCompany loads the :id company, answering 404 when it does not exist
+func (s *Server) findCompany(c *gin.Context) *client.Company {
+	company, ok := s.companies[c.Param("id")]
+	if !ok {
+		responses.NotFound(c, "record not found")
+		return nil
+	}
+	return company
+}
+
+// companyMember finds an active or invited member
+func (s *Server) companyMember(companyID, userID string) *client.CompanyMember {
+	for _, member := range s.companyMembers[companyID] {
+		if member.UserID == userID {
+			return member
+		}
+	}
+	return nil
+}
+
+// newRate validates a rate change like the service does, returning nil and
+// the error message when it is rejected
+func (s *Server) newRate(member *client.CompanyMember, compensation client.Compensation, setBy string) (*client.CompensationRate, string) {
+	if compensation.Salary == nil && compensation.HourlyRate == nil {
+		return nil, "salary or hourly rate is required"
+	}
+	if (compensation.Salary != nil && *compensation.Salary < 0) || (compensation.HourlyRate != nil && *compensation.HourlyRate < 0) {
+		return nil, "compensation cannot be negative"
+	}
+
+	currency := strings.ToUpper(compensation.Currency)
+	if currency == "" {
+		currency = member.Currency
+	}
+	if currency == "" {
+		currency = companiesService.DefaultCurrency
+	}
+	if len(currency) != 3 || strings.Trim(currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return nil, "invalid currency code"
+	}
+
+	now := time.Now()
+	effectiveFrom := now
+	if compensation.EffectiveFrom != nil {
+		effectiveFrom = *compensation.EffectiveFrom
+	}
+
+	return &client.CompensationRate{
+		ID:            s.newID(),
+		CompanyID:     member.CompanyID,
+		UserID:        member.UserID,
+		Salary:        compensation.Salary,
+		HourlyRate:    compensation.HourlyRate,
+		Currency:      currency,
+		EffectiveFrom: effectiveFrom,
+		SetBy:         setBy,
+		CreatedAt:     now,
+	}, ""
+}
+
+// currentRate is the latest rate in effect now; future-dated rates show once
+// they start
+func (s *Server) currentRate(companyID, userID string) *client.CompensationRate {
+	var current *client.CompensationRate
+	now := time.Now()
+	for i := range s.rates {
+		rate := &s.rates[i]
+		if rate.CompanyID != companyID || rate.UserID != userID || rate.EffectiveFrom.After(now) {
+			continue
+		}
+		if current == nil || rate.EffectiveFrom.After(current.EffectiveFrom) ||
+			(rate.EffectiveFrom.Equal(current.EffectiveFrom) && rate.ID > current.ID) {
+			current = rate
+		}
+	}
+	return current
+}
+
+// deleteRates drops a company's rates, or only one member's when userID is set
+func deleteRates(rates []client.CompensationRate, companyID, userID string) []client.CompensationRate {
+	kept := rates[:0]
+	for _, rate := range rates {
+		if rate.CompanyID == companyID && (userID == "" || rate.UserID == userID) {
+			continue
+		}
+		kept = append(kept, rate)
+	}
+	return kept
+}
+
+func newList[T any](data []T) types.ListResponse[T] {
+	return types.ListResponse[T]{
+		Data: data,
+		Meta: types.ResponseMetadata{
+			Count:     len(data),
+			Timestamp: time.Now(),
+		},
+	}
+}
@@ -0,0 +1,315 @@
+package clienttest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/client"
+	"github.com/JorgeSaicoski/go-project-manager/client/clienttest"
+)
+
+// The scenarios below pin the rules the fake re-implements. They always run
+// against the fake, and also against a running service when PROJECT_CORE_URL
+// is set, e.g. PROJECT_CORE_URL=http://localhost:8001, so a rule that drifts
+// between the two fails on one side. IDs are unique per run, so the service's
+// database can be shared.
+
+type backend struct {
+	name string
+	api  *client.Client
+}
+
+func backends(t *testing.T) []backend {
+	t.Helper()
+
+	server := clienttest.NewServer()
+	t.Cleanup(server.Close)
+
+	list := []backend{{"fake", server.Client()}}
+	if url := os.Getenv("PROJECT_CORE_URL"); url != "" {
+		list = append(list, backend{"service", client.New(url, client.Options{})})
+	}
+	return list
+}
+
+// runParity runs scenario once per backend, with a helper making user and
+// company IDs unique to the run
+func runParity(t *testing.T, scenario func(t *testing.T, api *client.Client, id func(string) string)) {
+	for _, b := range backends(t) {
+		t.Run(b.name, func(t *testing.T) {
+			suffix := fmt.Sprintf("-%d", time.Now().UnixNano())
+			scenario(t, b.api, func(name string) string { return name + suffix })
+		})
+	}
+}
+
+func wantErr(t *testing.T, err, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Fatalf("err = %v, want %v", err, target)
+	}
+}
+
+func TestProjectAccess(t *testing.T) {
+	runParity(t, func(t *testing.T, api *client.Client, id func(string) string) {
+		ctx := context.Background()
+		owner, reader, stranger := id("owner"), id("reader"), id("stranger")
+
+		parent, err := api.CreateProject(ctx, client.CreateProjectInput{Title: "Parent", OwnerID: owner})
+		if err != nil {
+			t.Fatal(err)
+		}
+		child, err := api.CreateProject(ctx, client.CreateProjectInput{Title: "Child", OwnerID: owner, ParentID: &parent.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.AddProjectMember(ctx, parent.ID, reader, "viewer", []string{"read"}, owner); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = api.GetProject(ctx, parent.ID, stranger)
+		wantErr(t, err, client.ErrForbidden)
+
+		// Access is inherited down the tree; update needs its own permission
+		if _, err := api.GetProject(ctx, child.ID, reader); err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.UpdateProject(ctx, child.ID, client.UpdateProjectInput{Title: "Renamed"}, reader)
+		wantErr(t, err, client.ErrForbidden)
+		_, err = api.AddProjectMember(ctx, child.ID, stranger, "viewer", []string{"read"}, reader)
+		wantErr(t, err, client.ErrForbidden)
+
+		updated, err := api.UpdateProject(ctx, child.ID, client.UpdateProjectInput{Title: "Renamed"}, owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated.Title != "Renamed" {
+			t.Errorf("title = %q, want %q", updated.Title, "Renamed")
+		}
+	})
+}
+
+func TestCompanyMembershipGrantsAccess(t *testing.T) {
+	runParity(t, func(t *testing.T, api *client.Client, id func(string) string) {
+		ctx := context.Background()
+		owner, member, invitee := id("owner"), id("member"), id("invitee")
+
+		company, err := api.CreateCompany(ctx, client.CreateCompanyInput{ID: id("acme"), Name: "Acme", Type: "personal", OwnerID: owner})
+		if err != nil {
+			t.Fatal(err)
+		}
+		project, err := api.CreateProject(ctx, client.CreateProjectInput{Title: "Roadmap", OwnerID: owner, CompanyID: &company.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.AddCompanyMember(ctx, company.ID, member, "member", nil, owner); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.InviteCompanyMember(ctx, company.ID, invitee, "member", owner); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := api.GetProject(ctx, project.ID, member); err != nil {
+			t.Fatal(err)
+		}
+
+		// Pending invitations give no access until accepted
+		_, err = api.GetProject(ctx, project.ID, invitee)
+		wantErr(t, err, client.ErrForbidden)
+		if _, err := api.AcceptInvitation(ctx, company.ID, invitee); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.GetProject(ctx, project.ID, invitee); err != nil {
+			t.Fatal(err)
+		}
+
+		// Removed members lose access
+		if err := api.RemoveCompanyMember(ctx, company.ID, member, owner); err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.GetProject(ctx, project.ID, member)
+		wantErr(t, err, client.ErrForbidden)
+	})
+}
+
+func TestCompanyRoles(t *testing.T) {
+	runParity(t, func(t *testing.T, api *client.Client, id func(string) string) {
+		ctx := context.Background()
+		owner, manager, member := id("owner"), id("manager"), id("member")
+
+		company, err := api.CreateCompany(ctx, client.CreateCompanyInput{ID: id("acme"), Name: "Acme", Type: "personal", OwnerID: owner})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.AddCompanyMember(ctx, company.ID, manager, "manager", nil, owner); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = api.AddCompanyMember(ctx, company.ID, member, "director", nil, owner)
+		wantErr(t, err, client.ErrBadRequest)
+		_, err = api.AddCompanyMember(ctx, company.ID, member, "owner", nil, owner)
+		wantErr(t, err, client.ErrBadRequest)
+
+		// Managers add members but cannot hand out admin
+		_, err = api.AddCompanyMember(ctx, company.ID, member, "admin", nil, manager)
+		wantErr(t, err, client.ErrForbidden)
+		if _, err := api.AddCompanyMember(ctx, company.ID, member, "member", nil, manager); err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.UpdateCompanyMemberRole(ctx, company.ID, member, "admin", manager)
+		wantErr(t, err, client.ErrForbidden)
+
+		promoted, err := api.UpdateCompanyMemberRole(ctx, company.ID, member, "admin", owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if promoted.Role != "admin" {
+			t.Errorf("role = %q, want admin", promoted.Role)
+		}
+
+		// Plain members cannot manage members
+		other := id("other")
+		if _, err := api.AddCompanyMember(ctx, company.ID, other, "member", nil, owner); err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.AddCompanyMember(ctx, company.ID, id("late"), "member", nil, other)
+		wantErr(t, err, client.ErrForbidden)
+	})
+}
+
+func TestCompensationVisibility(t *testing.T) {
+	runParity(t, func(t *testing.T, api *client.Client, id func(string) string) {
+		ctx := context.Background()
+		owner, paid, colleague := id("owner"), id("paid"), id("colleague")
+
+		company, err := api.CreateCompany(ctx, client.CreateCompanyInput{ID: id("acme"), Name: "Acme", Type: "personal", OwnerID: owner})
+		if err != nil {
+			t.Fatal(err)
+		}
+		salary := 60000.0
+		if _, err := api.AddCompanyMember(ctx, company.ID, paid, "member", &client.Compensation{Salary: &salary, Currency: "USD"}, owner); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.AddCompanyMember(ctx, company.ID, colleague, "member", nil, owner); err != nil {
+			t.Fatal(err)
+		}
+
+		salaryOf := func(viewer string) *float64 {
+			t.Helper()
+			members, err := api.GetCompanyMembers(ctx, company.ID, viewer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, member := range members {
+				if member.UserID == paid {
+					return member.Salary
+				}
+			}
+			t.Fatalf("%s not listed", paid)
+			return nil
+		}
+		if got := salaryOf(owner); got == nil || *got != salary {
+			t.Errorf("owner sees salary %v, want %v", got, salary)
+		}
+		if got := salaryOf(paid); got == nil || *got != salary {
+			t.Errorf("member sees own salary %v, want %v", got, salary)
+		}
+		if got := salaryOf(colleague); got != nil {
+			t.Errorf("colleague sees salary %v, want it hidden", *got)
+		}
+
+		_, err = api.GetMemberCompensationHistory(ctx, company.ID, paid, colleague)
+		wantErr(t, err, client.ErrForbidden)
+		_, err = api.SetMemberCompensation(ctx, company.ID, colleague, client.Compensation{Salary: &salary, Currency: "USD"}, colleague)
+		wantErr(t, err, client.ErrForbidden)
+	})
+}
+
+func TestImportChecksMemberManagement(t *testing.T) {
+	runParity(t, func(t *testing.T, api *client.Client, id func(string) string) {
+		ctx := context.Background()
+		owner, editor := id("owner"), id("editor")
+
+		company, err := api.CreateCompany(ctx, client.CreateCompanyInput{ID: id("acme"), Name: "Acme", Type: "personal", OwnerID: owner})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.AddCompanyMember(ctx, company.ID, editor, "manager", nil, owner); err != nil {
+			t.Fatal(err)
+		}
+		options := client.ImportOptions{CompanyID: &company.ID}
+
+		result, err := api.ImportProjects(ctx, strings.NewReader(`[{"externalKey": "Q3", "title": "Q3 audit", "status": "active"}]`), options, owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Created != 1 || result.Rows[0].ProjectID == nil {
+			t.Fatalf("result = %+v, want one created project", result)
+		}
+		projectID := *result.Rows[0].ProjectID
+		if _, err := api.AddProjectMember(ctx, projectID, editor, "editor", []string{"read", "update"}, owner); err != nil {
+			t.Fatal(err)
+		}
+
+		// Updating the project is allowed, changing its members is not
+		withMembers := fmt.Sprintf(`[{"externalKey": "Q3", "title": "Q3 audit", "status": "active", "members": [{"userId": %q, "role": "viewer"}]}]`, id("auditor"))
+		result, err = api.ImportProjects(ctx, strings.NewReader(withMembers), options, editor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Failed != 1 || result.Rows[0].Error != "user cannot update members of this project" {
+			t.Errorf("result = %+v, want the row rejected for member management", result)
+		}
+
+		result, err = api.ImportProjects(ctx, strings.NewReader(`[{"externalKey": "Q3", "title": "Q3 audit, revised", "status": "active"}]`), options, editor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Updated != 1 {
+			t.Errorf("result = %+v, want one updated project", result)
+		}
+		project, err := api.GetProject(ctx, projectID, owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if project.Title != "Q3 audit, revised" {
+			t.Errorf("title = %q, want %q", project.Title, "Q3 audit, revised")
+		}
+	})
+}
+
+func TestFailNextAndCalls(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	api := server.Client()
+	project, err := api.CreateProject(ctx, client.CreateProjectInput{Title: "Launch", OwnerID: "user-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := fmt.Sprintf("/projects/%d", project.ID)
+
+	// Reads are retried past a transient failure
+	server.FailNext("GET", path, 503, client.CodeServiceUnavailable, "restarting")
+	if _, err := api.GetProject(ctx, project.ID, "user-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.Calls("GET", path); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+
+	// Other failures are returned as is
+	server.FailNext("GET", path, 404, client.CodeNotFound, "project not found")
+	_, err = api.GetProject(ctx, project.ID, "user-1")
+	wantErr(t, err, client.ErrNotFound)
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "project not found" {
+		t.Errorf("err = %v, want the queued message", err)
+	}
+}
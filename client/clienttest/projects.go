@@ -0,0 +1,1242 @@
+package clienttest
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/client"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/customfields"
+	projectsService "github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+var validProjectStatuses = map[string]bool{
+	"active":    true,
+	"completed": true,
+	"paused":    true,
+	"cancelled": true,
+}
+
+func (s *Server) createProject(c *gin.Context) {
+	var req struct {
+		Title       string     `json:"title" binding:"required"`
+		Description *string    `json:"description"`
+		Status      string     `json:"status"`
+		CompanyID   *string    `json:"companyId"`
+		ParentID    *uint      `json:"parentId"`
+		StartDate   *time.Time `json:"startDate"`
+		EndDate     *time.Time `json:"endDate"`
+		OwnerID     string     `json:"ownerId" binding:"required"`
+		ProjectType *string    `json:"projectType"`
+
+		CustomFields map[string]interface{} `json:"customFields"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	project := &client.Project{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		OwnerID:     req.OwnerID,
+		CompanyID:   req.CompanyID,
+		ParentID:    req.ParentID,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		ProjectType: req.ProjectType,
+	}
+	project.CustomFields = mergeCustomFields(nil, req.CustomFields)
+	if message, status := s.insertProject(project); message != "" {
+		responses.Error(c, status, errorCode(status), message)
+		return
+	}
+
+	responses.Created(c, "Project created successfully", project)
+}
+
+func (s *Server) getProject(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanAccessProject(project, userID) {
+		responses.Forbidden(c, "user cannot access this project")
+		return
+	}
+
+	responses.Success(c, "Project retrieved successfully", project)
+}
+
+func (s *Server) updateProject(c *gin.Context) {
+	var req struct {
+		Title       string     `json:"title"`
+		Description *string    `json:"description"`
+		Status      string     `json:"status"`
+		StartDate   *time.Time `json:"startDate"`
+		EndDate     *time.Time `json:"endDate"`
+		UserID      string     `json:"userId" binding:"required"`
+
+		CustomFields map[string]interface{} `json:"customFields"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanUpdateProject(project, req.UserID) {
+		responses.Forbidden(c, "user cannot update this project")
+		return
+	}
+
+	if req.Title != "" {
+		project.Title = req.Title
+	}
+	if req.Description != nil {
+		project.Description = req.Description
+	}
+	if req.Status != "" {
+		project.CompletedAt = completedAt(project.Status, req.Status, project.CompletedAt)
+		project.Status = req.Status
+	}
+	if req.StartDate != nil {
+		project.StartDate = req.StartDate
+	}
+	if req.EndDate != nil {
+		project.EndDate = req.EndDate
+	}
+	if req.CustomFields != nil {
+		project.CustomFields = mergeCustomFields(project.CustomFields, req.CustomFields)
+	}
+	project.UpdatedAt = time.Now()
+
+	responses.Success(c, "Project updated successfully", project)
+}
+
+func (s *Server) deleteProject(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if project.OwnerID != userID {
+		responses.Forbidden(c, "only project owner can delete project")
+		return
+	}
+	if s.hasChildren(project.ID) {
+		responses.Conflict(c, "project has sub-projects")
+		return
+	}
+
+	s.removeProject(project.ID)
+	responses.Success(c, "Project deleted successfully", nil)
+}
+
+func (s *Server) getUserProjects(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	filter := make(map[string]string)
+	for _, field := range c.QueryArray("field") {
+		key, value, ok := strings.Cut(field, ":")
+		if !ok || key == "" {
+			responses.BadRequest(c, "invalid field filter, expected key:value")
+			return
+		}
+		filter[key] = value
+	}
+	tagged := len(c.QueryArray("tag")) > 0
+
+	projects := []client.Project{}
+	for _, project := range s.userProjects(userID) {
+		if !tagged && matchesCustomFields(&project, filter) {
+			projects = append(projects, project)
+		}
+	}
+	responses.Success(c, "Projects retrieved successfully", newList(projects))
+}
+
+// Project hierarchy
+
+func (s *Server) getProjectChildren(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanAccessProject(project, userID) {
+		responses.Forbidden(c, "user cannot access this project")
+		return
+	}
+
+	responses.Success(c, "Sub-projects retrieved successfully", newList(s.children(project.ID)))
+}
+
+func (s *Server) getProjectTree(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanAccessProject(project, userID) {
+		responses.Forbidden(c, "user cannot access this project")
+		return
+	}
+
+	responses.Success(c, "Project tree retrieved successfully", s.tree(*project))
+}
+
+func (s *Server) moveProject(c *gin.Context) {
+	var req struct {
+		ParentID *uint  `json:"parentId"`
+		UserID   string `json:"userId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanUpdateProject(project, req.UserID) {
+		responses.Forbidden(c, "user cannot update this project")
+		return
+	}
+
+	if req.ParentID != nil {
+		parent, ok := s.projects[*req.ParentID]
+		if !ok {
+			responses.BadRequest(c, "parent project not found")
+			return
+		}
+		if message, status := s.validateParent(project, parent, req.UserID); message != "" {
+			responses.Error(c, status, errorCode(status), message)
+			return
+		}
+		// A project cannot be moved below itself or one of its descendants
+		for ancestor := parent; ancestor != nil; ancestor = s.parent(ancestor) {
+			if ancestor.ID == project.ID {
+				responses.Conflict(c, "project hierarchy cannot contain cycles")
+				return
+			}
+		}
+	}
+
+	project.ParentID = req.ParentID
+	project.UpdatedAt = time.Now()
+	responses.Success(c, "Project moved successfully", project)
+}
+
+func (s *Server) cloneProject(c *gin.Context) {
+	var req struct {
+		Title          string     `json:"title"`
+		StartDate      *time.Time `json:"startDate"`
+		IncludeMembers bool       `json:"includeMembers"`
+		UserID         string     `json:"userId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	source := s.findProject(c)
+	if source == nil {
+		return
+	}
+	if !s.userCanAccessProject(source, req.UserID) {
+		responses.Forbidden(c, "user cannot access this project")
+		return
+	}
+
+	clone := &client.Project{
+		Title:       source.Title,
+		Description: source.Description,
+		Status:      "active",
+		OwnerID:     req.UserID,
+		CompanyID:   source.CompanyID,
+		ParentID:    source.ParentID,
+		StartDate:   source.StartDate,
+		EndDate:     source.EndDate,
+		ProjectType: source.ProjectType,
+	}
+	clone.CustomFields = mergeCustomFields(nil, source.CustomFields)
+	if req.Title != "" {
+		clone.Title = req.Title
+	}
+	if req.StartDate != nil {
+		clone.StartDate, clone.EndDate = projectsService.ShiftDates(source.StartDate, source.EndDate, *req.StartDate)
+	}
+	if message, status := s.insertProject(clone); message != "" {
+		responses.Error(c, status, errorCode(status), message)
+		return
+	}
+
+	var members []client.ProjectMember
+	if req.IncludeMembers {
+		now := time.Now()
+		for _, member := range s.projectMembers[source.ID] {
+			member.ProjectID = strconv.Itoa(int(clone.ID))
+			member.JoinedAt = now
+			members = append(members, member)
+		}
+		s.projectMembers[clone.ID] = members
+	}
+
+	responses.Created(c, "Project cloned successfully", gin.H{
+		"project": clone,
+		"members": members,
+	})
+}
+
+// Bulk operations
+
+func (s *Server) bulkUpdateStatus(c *gin.Context) {
+	var req struct {
+		bulkRequest
+		Status string `json:"status" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	if !validProjectStatuses[req.Status] {
+		responses.BadRequest(c, "invalid project status")
+		return
+	}
+
+	s.runBulk(c, "Project statuses updated", req.bulkRequest, func(project *client.Project) error {
+		if !s.userCanUpdateProject(project, req.UserID) {
+			return errors.New("user cannot update this project")
+		}
+		return nil
+	}, func(project *client.Project) {
+		project.CompletedAt = completedAt(project.Status, req.Status, project.CompletedAt)
+		project.Status = req.Status
+		project.UpdatedAt = time.Now()
+	})
+}
+
+func (s *Server) bulkReassignOwner(c *gin.Context) {
+	var req struct {
+		bulkRequest
+		OwnerID string `json:"ownerId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	s.runBulk(c, "Project owners reassigned", req.bulkRequest, func(project *client.Project) error {
+		if project.OwnerID != req.UserID {
+			return errors.New("only project owner can reassign project")
+		}
+		if project.CompanyID != nil && !s.userCanAccessCompany(*project.CompanyID, req.OwnerID) {
+			return errors.New("new owner is not an active member of the project's company")
+		}
+		return nil
+	}, func(project *client.Project) {
+		project.OwnerID = req.OwnerID
+		project.UpdatedAt = time.Now()
+	})
+}
+
+func (s *Server) bulkMoveToCompany(c *gin.Context) {
+	var req struct {
+		bulkRequest
+		CompanyID *string `json:"companyId"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	if req.CompanyID != nil && !s.userCanCreateInCompany(*req.CompanyID, req.UserID) {
+		responses.Forbidden(c, "user cannot create projects in this company")
+		return
+	}
+
+	s.runBulk(c, "Projects moved", req.bulkRequest, func(project *client.Project) error {
+		if project.OwnerID != req.UserID {
+			return errors.New("only project owner can move project")
+		}
+		if project.ParentID != nil {
+			return errors.New("sub-projects cannot change company")
+		}
+		if s.hasChildren(project.ID) {
+			return errors.New("project has sub-projects")
+		}
+		return nil
+	}, func(project *client.Project) {
+		// Team grants are company-scoped and do not follow the project
+		delete(s.projectTeams, project.ID)
+		project.CompanyID = req.CompanyID
+		project.UpdatedAt = time.Now()
+	})
+}
+
+func (s *Server) bulkDelete(c *gin.Context) {
+	var req bulkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	s.runBulk(c, "Projects deleted", req, func(project *client.Project) error {
+		if project.OwnerID != req.UserID {
+			return errors.New("only project owner can delete project")
+		}
+		if s.hasChildren(project.ID) {
+			return errors.New("project has sub-projects")
+		}
+		return nil
+	}, func(project *client.Project) {
+		s.removeProject(project.ID)
+	})
+}
+
+func (s *Server) bulkAddMember(c *gin.Context) {
+	var req bulkMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	if req.Role == "" {
+		responses.BadRequest(c, "Role required")
+		return
+	}
+
+	s.runBulk(c, "Member added to projects", req.bulkRequest, func(project *client.Project) error {
+		if !s.userCanManageProjectMembers(project, req.RequestingUserID) {
+			return errors.New("user cannot add members to this project")
+		}
+		if s.projectMember(project.ID, req.UserID) != nil {
+			return errors.New("user is already a member of this project")
+		}
+		return nil
+	}, func(project *client.Project) {
+		s.projectMembers[project.ID] = append(s.projectMembers[project.ID], newProjectMember(project.ID, req.UserID, req.Role, req.Permissions))
+	})
+}
+
+func (s *Server) bulkRemoveMember(c *gin.Context) {
+	var req bulkMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	s.runBulk(c, "Member removed from projects", req.bulkRequest, func(project *client.Project) error {
+		// Users can remove themselves
+		if req.UserID != req.RequestingUserID && !s.userCanManageProjectMembers(project, req.RequestingUserID) {
+			return errors.New("user cannot remove members from this project")
+		}
+		if s.projectMember(project.ID, req.UserID) == nil {
+			return errors.New("user is not a member of this project")
+		}
+		return nil
+	}, func(project *client.Project) {
+		members := s.projectMembers[project.ID]
+		for i := range members {
+			if members[i].UserID == req.UserID {
+				s.projectMembers[project.ID] = append(members[:i], members[i+1:]...)
+				break
+			}
+		}
+	})
+}
+
+// Import and export
+
+func (s *Server) exportProjects(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	var projects []client.Project
+	filename := "projects"
+	if companyID := c.Query("companyId"); companyID != "" {
+		if !s.userCanAccessCompany(companyID, userID) {
+			responses.Forbidden(c, "user cannot access this company")
+			return
+		}
+		for _, project := range s.projects {
+			if project.CompanyID != nil && *project.CompanyID == companyID {
+				projects = append(projects, *project)
+			}
+		}
+		sortProjects(projects)
+		filename = "company-" + companyID + "-projects"
+	} else {
+		projects = s.userProjects(userID)
+	}
+
+	var writer projectsService.RecordWriter
+	switch c.DefaultQuery("format", "json") {
+	case "csv":
+		c.Header("Content-Type", "text/csv")
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`.csv"`)
+		writer = projectsService.NewCSVRecordWriter(c.Writer)
+	case "json":
+		c.Header("Content-Type", "application/json")
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`.json"`)
+		writer = projectsService.NewJSONRecordWriter(c.Writer)
+	default:
+		responses.BadRequest(c, "Invalid format, expected csv or json")
+		return
+	}
+
+	for i := range projects {
+		record := &projectsService.ProjectRecord{
+			ID:           projects[i].ID,
+			Title:        projects[i].Title,
+			Description:  projects[i].Description,
+			Status:       projects[i].Status,
+			StartDate:    projects[i].StartDate,
+			EndDate:      projects[i].EndDate,
+			CompanyID:    projects[i].CompanyID,
+			ProjectType:  projects[i].ProjectType,
+			CustomFields: projects[i].CustomFields,
+		}
+		if projects[i].ExternalKey != nil {
+			record.ExternalKey = *projects[i].ExternalKey
+		}
+		for _, member := range s.projectMembers[projects[i].ID] {
+			record.Members = append(record.Members, projectsService.MemberRecord{
+				UserID:      member.UserID,
+				Role:        member.Role,
+				Permissions: member.Permissions,
+			})
+		}
+		if err := writer.Write(record); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+	if err := writer.Close(); err != nil {
+		c.Error(err)
+	}
+}
+
+func (s *Server) importProjects(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	dryRun := c.Query("dryRun") == "true"
+	var companyID *string
+	if id := c.Query("companyId"); id != "" {
+		companyID = &id
+		if !s.userCanCreateInCompany(id, userID) {
+			responses.Forbidden(c, "user cannot create projects in this company")
+			return
+		}
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		format = "json"
+		if strings.HasPrefix(c.ContentType(), "text/csv") {
+			format = "csv"
+		}
+	}
+
+	var reader projectsService.RecordReader
+	var err error
+	switch format {
+	case "csv":
+		reader, err = projectsService.NewCSVRecordReader(c.Request.Body)
+	case "json":
+		reader, err = projectsService.NewJSONRecordReader(c.Request.Body)
+	default:
+		responses.BadRequest(c, "Invalid format, expected csv or json")
+		return
+	}
+	if err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	var records []*projectsService.ProjectRecord
+	var readErrors []string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if len(records) == projectsService.MaxImportRows {
+			responses.BadRequest(c, "import exceeds maximum of "+strconv.Itoa(projectsService.MaxImportRows)+" rows")
+			return
+		}
+		var message string
+		if err != nil {
+			var recordErr *projectsService.RecordError
+			if !errors.As(err, &recordErr) {
+				responses.BadRequest(c, err.Error())
+				return
+			}
+			message = recordErr.Message
+		}
+		records = append(records, record)
+		readErrors = append(readErrors, message)
+	}
+
+	result := client.ImportResult{DryRun: dryRun, Rows: []client.ImportRowResult{}}
+	seen := make(map[string]int)
+	for i, record := range records {
+		row := i + 1
+		rowResult := client.ImportRowResult{Row: row, Action: "skip"}
+		if readErrors[i] != "" {
+			rowResult.Error = readErrors[i]
+			result.Rows = append(result.Rows, rowResult)
+			continue
+		}
+		rowResult.ExternalKey = record.ExternalKey
+
+		if first, ok := seen[record.ExternalKey]; ok && record.ExternalKey != "" {
+			rowResult.Error = "duplicate external key, first used on row " + strconv.Itoa(first)
+			result.Rows = append(result.Rows, rowResult)
+			continue
+		}
+		seen[record.ExternalKey] = row
+
+		if message := s.importRecord(record, companyID, dryRun, userID, &rowResult); message != "" {
+			rowResult.Action = "skip"
+			rowResult.ProjectID = nil
+			rowResult.Error = message
+		}
+		result.Rows = append(result.Rows, rowResult)
+	}
+
+	for _, row := range result.Rows {
+		switch {
+		case row.Error != "":
+			result.Failed++
+		case row.Action == "create":
+			result.Created++
+		case row.Action == "update":
+			result.Updated++
+		}
+	}
+
+	switch {
+	case result.Failed > 0:
+		responses.SuccessWithStatus(c, http.StatusMultiStatus, "Import completed with failures", result)
+	case result.DryRun:
+		responses.Success(c, "Import validated successfully", result)
+	default:
+		responses.Success(c, "Import completed successfully", result)
+	}
+}
+
+// Project members
+
+func (s *Server) getProjectMembers(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanAccessProject(project, userID) {
+		responses.Forbidden(c, "user cannot access this project")
+		return
+	}
+
+	members := append([]client.ProjectMember{}, s.projectMembers[project.ID]...)
+	responses.Success(c, "Members retrieved successfully", gin.H{
+		"members": members,
+	})
+}
+
+func (s *Server) addProjectMember(c *gin.Context) {
+	var req struct {
+		UserID           string   `json:"userId" binding:"required"`
+		Role             string   `json:"role" binding:"required"`
+		Permissions      []string `json:"permissions"`
+		RequestingUserID string   `json:"requestingUserId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanManageProjectMembers(project, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot add members to this project")
+		return
+	}
+	if s.projectMember(project.ID, req.UserID) != nil {
+		responses.Conflict(c, "user is already a member of this project")
+		return
+	}
+
+	member := newProjectMember(project.ID, req.UserID, req.Role, req.Permissions)
+	s.projectMembers[project.ID] = append(s.projectMembers[project.ID], member)
+	responses.Created(c, "Member added successfully", member)
+}
+
+func (s *Server) updateProjectMember(c *gin.Context) {
+	var req struct {
+		RequestingUserID string   `json:"requestingUserId" binding:"required"`
+		Role             string   `json:"role"`
+		Permissions      []string `json:"permissions"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanManageProjectMembers(project, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot update members of this project")
+		return
+	}
+	member := s.projectMember(project.ID, c.Param("userId"))
+	if member == nil {
+		responses.NotFound(c, "user is not a member of this project")
+		return
+	}
+
+	if req.Role != "" {
+		member.Role = req.Role
+	}
+	if req.Permissions != nil {
+		member.Permissions = req.Permissions
+	}
+	responses.Success(c, "Member updated successfully", member)
+}
+
+// Project teams. The fake does not know which users belong to a team, so
+// grants are stored and listed but never give access.
+
+func (s *Server) getProjectTeams(c *gin.Context) {
+	userID := requestUserID(c)
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanAccessProject(project, userID) {
+		responses.Forbidden(c, "user cannot access this project")
+		return
+	}
+
+	teams := append([]client.ProjectTeam{}, s.projectTeams[project.ID]...)
+	responses.Success(c, "Teams retrieved successfully", gin.H{
+		"teams": teams,
+	})
+}
+
+func (s *Server) addProjectTeam(c *gin.Context) {
+	var req struct {
+		TeamID           uint     `json:"teamId" binding:"required"`
+		Role             string   `json:"role" binding:"required"`
+		Permissions      []string `json:"permissions"`
+		RequestingUserID string   `json:"requestingUserId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanManageProjectMembers(project, req.RequestingUserID) {
+		responses.Forbidden(c, "user cannot add members to this project")
+		return
+	}
+	if project.CompanyID == nil {
+		responses.BadRequest(c, "team and project belong to different companies")
+		return
+	}
+	for _, grant := range s.projectTeams[project.ID] {
+		if grant.TeamID == req.TeamID {
+			responses.Conflict(c, "team already has access to this project")
+			return
+		}
+	}
+
+	grant := client.ProjectTeam{
+		ID:          s.newID(),
+		ProjectID:   project.ID,
+		TeamID:      req.TeamID,
+		Role:        req.Role,
+		Permissions: req.Permissions,
+		GrantedBy:   req.RequestingUserID,
+		GrantedAt:   time.Now(),
+	}
+	s.projectTeams[project.ID] = append(s.projectTeams[project.ID], grant)
+	responses.Created(c, "Team added successfully", grant)
+}
+
+func (s *Server) removeProjectTeam(c *gin.Context) {
+	teamID, err := strconv.ParseUint(c.Param("teamId"), 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+	requestingUserID := c.GetHeader("X-User-ID")
+	if requestingUserID == "" {
+		responses.BadRequest(c, "Requesting User ID required")
+		return
+	}
+	project := s.findProject(c)
+	if project == nil {
+		return
+	}
+	if !s.userCanManageProjectMembers(project, requestingUserID) {
+		responses.Forbidden(c, "user cannot remove members from this project")
+		return
+	}
+
+	grants := s.projectTeams[project.ID]
+	for i := range grants {
+		if grants[i].TeamID == uint(teamID) {
+			s.projectTeams[project.ID] = append(grants[:i], grants[i+1:]...)
+			break
+		}
+	}
+	responses.Success(c, "Team removed successfully", nil)
+}
+
+// Private helper methods
+
+type bulkRequest struct {
+	ProjectIDs []uint `json:"projectIds" binding:"required"`
+	Atomic     bool   `json:"atomic"`
+	UserID     string `json:"userId" binding:"required"`
+}
+
+type bulkMemberRequest struct {
+	bulkRequest
+	Role             string   `json:"role"`
+	Permissions      []string `json:"permissions"`
+	RequestingUserID string   `json:"requestingUserId" binding:"required"`
+}
+
+// runBulk validates every project before applying the change to the valid
+// ones, or to none of them when an atomic batch has a failure, and responds
+// like the service: 200, 207 on partial failure and 422 on rollback
+func (s *Server) runBulk(c *gin.Context, message string, req bulkRequest, validate func(*client.Project) error, apply func(*client.Project)) {
+	var projectIDs []uint
+	seen := make(map[uint]bool, len(req.ProjectIDs))
+	for _, id := range req.ProjectIDs {
+		if !seen[id] {
+			seen[id] = true
+			projectIDs = append(projectIDs, id)
+		}
+	}
+	if len(projectIDs) == 0 {
+		responses.BadRequest(c, "at least one project ID is required")
+		return
+	}
+	if len(projectIDs) > projectsService.MaxBulkItems {
+		responses.BadRequest(c, "bulk request exceeds maximum of "+strconv.Itoa(projectsService.MaxBulkItems)+" projects")
+		return
+	}
+
+	result := client.BulkResult{Results: make([]client.BulkItemResult, len(projectIDs)), Atomic: req.Atomic}
+	valid := 0
+	for i, id := range projectIDs {
+		result.Results[i].ProjectID = id
+		project, ok := s.projects[id]
+		if !ok {
+			result.Results[i].Error = "project not found"
+			continue
+		}
+		if err := validate(project); err != nil {
+			result.Results[i].Error = err.Error()
+			continue
+		}
+		valid++
+	}
+
+	if req.Atomic && valid < len(projectIDs) {
+		result.RolledBack = true
+		for i := range result.Results {
+			if result.Results[i].Error == "" {
+				result.Results[i].Error = "not applied: batch was rolled back"
+			}
+		}
+	} else {
+		for i := range result.Results {
+			if result.Results[i].Error == "" {
+				apply(s.projects[result.Results[i].ProjectID])
+				result.Results[i].Success = true
+			}
+		}
+	}
+
+	for _, item := range result.Results {
+		if item.Success {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+
+	switch {
+	case result.RolledBack:
+		responses.ErrorWithMetadata(c, http.StatusUnprocessableEntity, responses.ErrCodeUnprocessableEntity, "Bulk operation rolled back", result)
+	case result.Failed > 0:
+		responses.SuccessWithStatus(c, http.StatusMultiStatus, message+" with failures", result)
+	default:
+		responses.Success(c, message+" successfully", result)
+	}
+}
+
+// findProject loads the :id project, answering 400 for a malformed ID and 404
+// when it does not exist
+func (s *Server) findProject(c *gin.Context) *client.Project {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return nil
+	}
+	project, ok := s.projects[uint(id)]
+	if !ok {
+		responses.NotFound(c, "Project not found")
+		return nil
+	}
+	return project
+}
+
+// insertProject applies the create rules and defaults of the service and
+// stores the project, returning the error message and status on failure
+func (s *Server) insertProject(project *client.Project) (string, int) {
+	// Sub-projects inherit the parent's company and are authorized through the parent
+	if project.ParentID != nil {
+		parent, ok := s.projects[*project.ParentID]
+		if !ok {
+			return "parent project not found", http.StatusBadRequest
+		}
+		if project.CompanyID == nil {
+			project.CompanyID = parent.CompanyID
+		}
+		if message, status := s.validateParent(project, parent, project.OwnerID); message != "" {
+			return message, status
+		}
+	} else if project.CompanyID != nil && !s.userCanCreateInCompany(*project.CompanyID, project.OwnerID) {
+		// The service reports this as an internal error on create
+		return "user cannot create projects in this company", http.StatusInternalServerError
+	}
+
+	if project.Status == "" {
+		project.Status = "active"
+	}
+	project.CompletedAt = completedAt("", project.Status, nil)
+	now := time.Now()
+	project.CreatedAt = now
+	project.UpdatedAt = now
+	project.ID = s.newID()
+	s.projects[project.ID] = project
+	return "", 0
+}
+
+func (s *Server) validateParent(project, parent *client.Project, userID string) (string, int) {
+	if !s.userCanUpdateProject(parent, userID) {
+		return "user cannot add sub-projects to this project", http.StatusForbidden
+	}
+	if !sameCompany(project.CompanyID, parent.CompanyID) {
+		return "sub-project must belong to the parent's company", http.StatusBadRequest
+	}
+	return "", 0
+}
+
+// importRecord upserts one record by external key, returning the error
+// message when the row is rejected
+func (s *Server) importRecord(record *projectsService.ProjectRecord, companyID *string, dryRun bool, userID string, rowResult *client.ImportRowResult) string {
+	switch {
+	case record.ExternalKey == "":
+		return "external key is required"
+	case record.Title == "":
+		return "title is required"
+	}
+	if record.Status == "" {
+		record.Status = "active"
+	}
+	if !validProjectStatuses[record.Status] {
+		return "invalid project status"
+	}
+	if record.StartDate != nil && record.EndDate != nil && record.EndDate.Before(*record.StartDate) {
+		return "end date is before start date"
+	}
+	for _, member := range record.Members {
+		if member.UserID == "" || member.Role == "" {
+			return "member user ID and role are required"
+		}
+	}
+
+	var existing *client.Project
+	for _, project := range s.projects {
+		if project.ExternalKey == nil || *project.ExternalKey != record.ExternalKey {
+			continue
+		}
+		if companyID != nil && sameCompany(project.CompanyID, companyID) ||
+			companyID == nil && project.CompanyID == nil && project.OwnerID == userID {
+			existing = project
+			break
+		}
+	}
+
+	if existing != nil {
+		if !s.userCanUpdateProject(existing, userID) {
+			return "user cannot update this project"
+		}
+		if len(record.Members) > 0 && !s.userCanManageProjectMembers(existing, userID) {
+			return "user cannot update members of this project"
+		}
+		rowResult.Action = "update"
+		rowResult.ProjectID = &existing.ID
+	} else {
+		rowResult.Action = "create"
+	}
+	if dryRun {
+		return ""
+	}
+
+	now := time.Now()
+	project := existing
+	if project == nil {
+		externalKey := record.ExternalKey
+		project = &client.Project{
+			ID:          s.newID(),
+			OwnerID:     userID,
+			CompanyID:   companyID,
+			ExternalKey: &externalKey,
+			ProjectType: record.ProjectType,
+			CreatedAt:   now,
+		}
+		s.projects[project.ID] = project
+		rowResult.ProjectID = &project.ID
+	}
+	project.Title = record.Title
+	project.Description = record.Description
+	project.CompletedAt = completedAt(project.Status, record.Status, project.CompletedAt)
+	project.Status = record.Status
+	project.StartDate = record.StartDate
+	project.EndDate = record.EndDate
+	if record.CustomFields != nil {
+		project.CustomFields = mergeCustomFields(project.CustomFields, record.CustomFields)
+	}
+	project.UpdatedAt = now
+
+	for _, memberRecord := range record.Members {
+		if member := s.projectMember(project.ID, memberRecord.UserID); member != nil {
+			member.Role = memberRecord.Role
+			member.Permissions = memberRecord.Permissions
+			continue
+		}
+		s.projectMembers[project.ID] = append(s.projectMembers[project.ID], newProjectMember(project.ID, memberRecord.UserID, memberRecord.Role, memberRecord.Permissions))
+	}
+	return ""
+}
+
+func (s *Server) removeProject(id uint) {
+	delete(s.projects, id)
+	delete(s.projectMembers, id)
+	delete(s.projectTeams, id)
+}
+
+// userProjects lists the projects the user owns or is a member of
+func (s *Server) userProjects(userID string) []client.Project {
+	projects := []client.Project{}
+	for _, project := range s.projects {
+		if project.OwnerID == userID || s.projectMember(project.ID, userID) != nil {
+			projects = append(projects, *project)
+		}
+	}
+	sortProjects(projects)
+	return projects
+}
+
+// mergeCustomFields applies input to current, where a nil value clears the
+// field. The fake has no field definitions, so values are stored as given.
+func mergeCustomFields(current, input map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	for key, value := range current {
+		values[key] = value
+	}
+	for key, value := range input {
+		if value == nil {
+			delete(values, key)
+			continue
+		}
+		values[key] = value
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func matchesCustomFields(project *client.Project, filter map[string]string) bool {
+	for key, want := range filter {
+		if !customfields.MatchesValue(project.CustomFields[key], want) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) children(id uint) []client.Project {
+	children := []client.Project{}
+	for _, project := range s.projects {
+		if project.ParentID != nil && *project.ParentID == id {
+			children = append(children, *project)
+		}
+	}
+	sortProjects(children)
+	return children
+}
+
+func (s *Server) hasChildren(id uint) bool {
+	for _, project := range s.projects {
+		if project.ParentID != nil && *project.ParentID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// tree builds the subtree rooted at project with its roll-up, children first
+func (s *Server) tree(project client.Project) client.ProjectTree {
+	node := client.ProjectTree{
+		Project:  project,
+		Children: []client.ProjectTree{},
+		Rollup: client.ProjectRollup{
+			StartDate:    project.StartDate,
+			EndDate:      project.EndDate,
+			ProjectCount: 1,
+		},
+	}
+	statuses := []string{project.Status}
+
+	for _, child := range s.children(project.ID) {
+		childNode := s.tree(child)
+		node.Children = append(node.Children, childNode)
+		node.Rollup.ProjectCount += childNode.Rollup.ProjectCount
+		statuses = append(statuses, childNode.Rollup.Status)
+
+		if start := childNode.Rollup.StartDate; start != nil && (node.Rollup.StartDate == nil || start.Before(*node.Rollup.StartDate)) {
+			node.Rollup.StartDate = start
+		}
+		if end := childNode.Rollup.EndDate; end != nil && (node.Rollup.EndDate == nil || end.After(*node.Rollup.EndDate)) {
+			node.Rollup.EndDate = end
+		}
+	}
+
+	node.Rollup.Status = rollupStatus(statuses)
+	return node
+}
+
+// rollupStatus derives a subtree status: any active work keeps it active, then
+// paused work, and it is only cancelled when everything in it was cancelled
+func rollupStatus(statuses []string) string {
+	counts := make(map[string]int)
+	for _, status := range statuses {
+		counts[status]++
+	}
+
+	switch {
+	case counts["active"] > 0:
+		return "active"
+	case counts["paused"] > 0:
+		return "paused"
+	case counts["cancelled"] == len(statuses):
+		return "cancelled"
+	default:
+		return "completed"
+	}
+}
+
+func newProjectMember(projectID uint, userID, role string, permissions []string) client.ProjectMember {
+	return client.ProjectMember{
+		ProjectID:   strconv.Itoa(int(projectID)),
+		ProjectType: "core",
+		UserID:      userID,
+		Role:        role,
+		Permissions: permissions,
+		JoinedAt:    time.Now(),
+	}
+}
+
+func completedAt(oldStatus, newStatus string, current *time.Time) *time.Time {
+	if newStatus != "completed" {
+		return nil
+	}
+	if oldStatus == "completed" {
+		return current
+	}
+	now := time.Now()
+	return &now
+}
+
+func sameCompany(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func sortProjects(projects []client.Project) {
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+}
+
+func errorCode(status int) string {
+	switch status {
+	case http.StatusForbidden:
+		return responses.ErrCodeForbidden
+	case http.StatusNotFound:
+		return responses.ErrCodeNotFound
+	case http.StatusConflict:
+		return responses.ErrCodeConflict
+	case http.StatusInternalServerError:
+		return responses.ErrCodeInternalError
+	}
+	return responses.ErrCodeBadRequest
+}
@@ -0,0 +1,359 @@
+// Package clienttest provides an in-memory fake of the project-core internal
+// API for testing code that uses package client.
+//
+// The fake serves the same routes, payloads and error codes as the service and
+// applies the same ownership and membership rules, without a database:
+//
+//	server := clienttest.NewServer()
+//	defer server.Close()
+//
+//	api := server.Client()
+//	project, err := api.CreateProject(ctx, client.CreateProjectInput{Title: "Q3 audit", OwnerID: "user-1"})
+//
+// Seed data through the client. FailNext injects error responses, e.g. a 503
+// to exercise retries, and Calls counts the requests that reached the fake.
+// Custom field definitions are not modelled: project custom field values are
+// stored as given and the project type is not checked. Tags are not modelled
+// either, so a tag filter matches no project.
+package clienttest
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/client"
+	companiesService "github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+// Server is a running fake. Its state is shared by every client and guarded by
+// a single lock, so requests are applied one at a time.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	templates *companiesService.TemplateRegistry
+	failures  []failure
+	calls     map[string]int
+
+	projects       map[uint]*client.Project
+	projectMembers map[uint][]client.ProjectMember
+	projectTeams   map[uint][]client.ProjectTeam
+	companies      map[string]*client.Company
+	companyMembers map[string][]*client.CompanyMember
+	companyRoles   map[string][]client.CompanyRole
+	rates          []client.CompensationRate
+	nextID         uint
+}
+
+type failure struct {
+	method  string
+	path    string
+	status  int
+	code    string
+	message string
+}
+
+// NewServer starts a fake with no data and the built-in company templates.
+// Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		templates:      companiesService.NewTemplateRegistry(),
+		calls:          make(map[string]int),
+		projects:       make(map[uint]*client.Project),
+		projectMembers: make(map[uint][]client.ProjectMember),
+		projectTeams:   make(map[uint][]client.ProjectTeam),
+		companies:      make(map[string]*client.Company),
+		companyMembers: make(map[string][]*client.CompanyMember),
+		companyRoles:   make(map[string][]client.CompanyRole),
+	}
+	s.Server = httptest.NewServer(s.router())
+	return s
+}
+
+// Client returns a client for the fake with short backoffs so retries do not
+// slow tests down
+func (s *Server) Client() *client.Client {
+	return client.New(s.URL, client.Options{
+		HTTPClient: s.Server.Client(),
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	})
+}
+
+// FailNext makes the next request for method and path fail with status, code
+// and message instead of reaching the fake. Paths are relative to
+// /api/internal, e.g. "/projects/1". Queued failures are used in order, so
+// calling FailNext twice fails the next two matching requests.
+func (s *Server) FailNext(method, path string, status int, code, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{method, path, status, code, message})
+}
+
+// Calls returns how many requests for method and path were received, including
+// failed ones
+func (s *Server) Calls(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method+" "+path]
+}
+
+// Private helper methods
+
+const apiPrefix = "/api/internal"
+
+func (s *Server) router() *gin.Engine {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+
+	internal := router.Group(apiPrefix, s.intercept)
+
+	projects := internal.Group("/projects")
+	projects.POST("", s.createProject)
+	projects.GET("", s.getUserProjects)
+	projects.GET("/export", s.exportProjects)
+	projects.POST("/import", s.importProjects)
+	projects.POST("/bulk/status", s.bulkUpdateStatus)
+	projects.POST("/bulk/owner", s.bulkReassignOwner)
+	projects.POST("/bulk/company", s.bulkMoveToCompany)
+	projects.POST("/bulk/delete", s.bulkDelete)
+	projects.POST("/bulk/members/add", s.bulkAddMember)
+	projects.POST("/bulk/members/remove", s.bulkRemoveMember)
+	projects.GET("/:id", s.getProject)
+	projects.PUT("/:id", s.updateProject)
+	projects.DELETE("/:id", s.deleteProject)
+	projects.GET("/:id/children", s.getProjectChildren)
+	projects.GET("/:id/tree", s.getProjectTree)
+	projects.PUT("/:id/parent", s.moveProject)
+	projects.POST("/:id/clone", s.cloneProject)
+	projects.GET("/:id/members", s.getProjectMembers)
+	projects.POST("/:id/members", s.addProjectMember)
+	projects.PUT("/:id/members/:userId", s.updateProjectMember)
+	projects.GET("/:id/teams", s.getProjectTeams)
+	projects.POST("/:id/teams", s.addProjectTeam)
+	projects.DELETE("/:id/teams/:teamId", s.removeProjectTeam)
+
+	companies := internal.Group("/companies")
+	companies.POST("", s.createCompany)
+	companies.GET("", s.getUserCompanies)
+	companies.GET("/invitations", s.getUserInvitations)
+	companies.GET("/:id", s.getCompany)
+	companies.PUT("/:id", s.updateCompany)
+	companies.DELETE("/:id", s.deleteCompany)
+	companies.GET("/:id/roles", s.getCompanyRoles)
+	companies.GET("/:id/members", s.getCompanyMembers)
+	companies.POST("/:id/members", s.addCompanyMember)
+	companies.DELETE("/:id/members/:userId", s.removeCompanyMember)
+	companies.PUT("/:id/members/:userId/role", s.updateCompanyMemberRole)
+	companies.POST("/:id/invitations", s.inviteCompanyMember)
+	companies.POST("/:id/invitations/accept", s.acceptInvitation)
+	companies.PUT("/:id/members/:userId/compensation", s.setMemberCompensation)
+	companies.GET("/:id/members/:userId/compensation", s.getMemberCompensationHistory)
+	internal.GET("/company-templates", s.getCompanyTemplates)
+
+	return router
+}
+
+// intercept counts the request, answers with a queued failure if one matches
+// and otherwise runs the handler under the lock
+func (s *Server) intercept(c *gin.Context) {
+	method := c.Request.Method
+	path := strings.TrimPrefix(c.Request.URL.Path, apiPrefix)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls[method+" "+path]++
+	for i, f := range s.failures {
+		if f.method == method && f.path == path {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			responses.Error(c, f.status, f.code, f.message)
+			c.Abort()
+			return
+		}
+	}
+	c.Next()
+}
+
+func (s *Server) newID() uint {
+	s.nextID++
+	return s.nextID
+}
+
+// requestUserID reads the caller from the userId query parameter or the
+// X-User-ID header, like the service
+func requestUserID(c *gin.Context) string {
+	if userID := c.Query("userId"); userID != "" {
+		return userID
+	}
+	return c.GetHeader("X-User-ID")
+}
+
+// Permission rules, mirroring the project and company services
+
+func (s *Server) activeCompanyMember(companyID, userID string) *client.CompanyMember {
+	for _, member := range s.companyMembers[companyID] {
+		if member.UserID == userID && member.Status == "active" {
+			return member
+		}
+	}
+	return nil
+}
+
+func (s *Server) companyRole(companyID, userID string) string {
+	if member := s.activeCompanyMember(companyID, userID); member != nil {
+		return member.Role
+	}
+	return ""
+}
+
+func (s *Server) userCanAccessCompany(companyID, userID string) bool {
+	return s.activeCompanyMember(companyID, userID) != nil
+}
+
+// roles lists the company's seeded roles, or the default roles when its
+// template seeds none
+func (s *Server) roles(companyID string) []client.CompanyRole {
+	if roles := s.companyRoles[companyID]; len(roles) > 0 {
+		return roles
+	}
+	roles := make([]client.CompanyRole, len(companiesService.DefaultRoles))
+	for i, role := range companiesService.DefaultRoles {
+		roles[i] = client.CompanyRole{CompanyID: companyID, Name: role.Name, Permissions: role.Permissions}
+	}
+	return roles
+}
+
+func (s *Server) validRole(companyID, role string) bool {
+	if role == companiesService.OwnerRole {
+		return false
+	}
+	for _, r := range s.roles(companyID) {
+		if r.Name == role {
+			return true
+		}
+	}
+	return false
+}
+
+// companyPermission allows the owner and active members whose role carries
+// permission or admin
+func (s *Server) companyPermission(companyID, userID, permission string) bool {
+	if company := s.companies[companyID]; company != nil && company.OwnerID == userID {
+		return true
+	}
+	role := s.companyRole(companyID, userID)
+	for _, r := range s.roles(companyID) {
+		if r.Name == role {
+			return hasPermission(r.Permissions, permission)
+		}
+	}
+	return false
+}
+
+func (s *Server) userCanUpdateCompany(company *client.Company, userID string) bool {
+	return s.companyPermission(company.ID, userID, companiesService.AdminPermission)
+}
+
+func (s *Server) userCanManageCompanyMembers(company *client.Company, userID string) bool {
+	return s.companyPermission(company.ID, userID, companiesService.ManageMembersPermission)
+}
+
+func (s *Server) userCanManageCompensation(company *client.Company, userID string) bool {
+	return s.companyPermission(company.ID, userID, companiesService.FinancePermission)
+}
+
+// canAssignRole keeps roles carrying the admin permission in the hands of the
+// owner and admins
+func (s *Server) canAssignRole(company *client.Company, role, userID string) bool {
+	for _, r := range s.roles(company.ID) {
+		if r.Name == role && hasPermission(r.Permissions, companiesService.AdminPermission) {
+			return s.userCanUpdateCompany(company, userID)
+		}
+	}
+	return true
+}
+
+// memberView hides compensation unless the caller is the member or can manage
+// compensation
+func (s *Server) memberView(company *client.Company, member *client.CompanyMember, userID string) client.CompanyMember {
+	view := *member
+	if member.UserID != userID && !s.userCanManageCompensation(company, userID) {
+		view.Salary, view.HourlyRate, view.Currency = nil, nil, ""
+	}
+	return view
+}
+
+func (s *Server) userCanCreateInCompany(companyID, userID string) bool {
+	return s.companyPermission(companyID, userID, companiesService.CreateProjectsPermission)
+}
+
+func (s *Server) projectMember(projectID uint, userID string) *client.ProjectMember {
+	members := s.projectMembers[projectID]
+	for i := range members {
+		if members[i].UserID == userID {
+			return &members[i]
+		}
+	}
+	return nil
+}
+
+// userCanAccessProject allows owners, members, members of the project's
+// company and anyone who can access an ancestor
+func (s *Server) userCanAccessProject(project *client.Project, userID string) bool {
+	if project.OwnerID == userID || s.projectMember(project.ID, userID) != nil {
+		return true
+	}
+	if project.CompanyID != nil && s.userCanAccessCompany(*project.CompanyID, userID) {
+		return true
+	}
+	if parent := s.parent(project); parent != nil {
+		return s.userCanAccessProject(parent, userID)
+	}
+	return false
+}
+
+func (s *Server) userCanUpdateProject(project *client.Project, userID string) bool {
+	return s.projectPermission(project, userID, "update")
+}
+
+func (s *Server) userCanManageProjectMembers(project *client.Project, userID string) bool {
+	return s.projectPermission(project, userID, "manage_members")
+}
+
+// projectPermission allows owners and members holding permission or admin, on
+// the project or any ancestor
+func (s *Server) projectPermission(project *client.Project, userID, permission string) bool {
+	if project.OwnerID == userID {
+		return true
+	}
+	if member := s.projectMember(project.ID, userID); member != nil {
+		if hasPermission(member.Permissions, permission) {
+			return true
+		}
+	}
+	if parent := s.parent(project); parent != nil {
+		return s.projectPermission(parent, userID, permission)
+	}
+	return false
+}
+
+func (s *Server) parent(project *client.Project) *client.Project {
+	if project.ParentID == nil {
+		return nil
+	}
+	return s.projects[*project.ParentID]
+}
+
+func hasPermission(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission || p == "admin" {
+			return true
+		}
+	}
+	return false
+}
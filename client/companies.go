@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CreateCompany creates a company owned by input.OwnerID, seeded from the
+// template for input.Type
+func (c *Client) CreateCompany(ctx context.Context, input CreateCompanyInput) (*Company, error) {
+	var company Company
+	if err := c.do(ctx, call{method: http.MethodPost, path: "/companies", userID: input.OwnerID, body: input}, &company); err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+func (c *Client) GetCompany(ctx context.Context, id string, userID string) (*Company, error) {
+	var company Company
+	if err := c.do(ctx, call{method: http.MethodGet, path: companyPath(id), userID: userID}, &company); err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+func (c *Client) UpdateCompany(ctx context.Context, id string, input UpdateCompanyInput, userID string) (*Company, error) {
+	body := struct {
+		UpdateCompanyInput
+		UserID string `json:"userId"`
+	}{input, userID}
+
+	var company Company
+	if err := c.do(ctx, call{method: http.MethodPut, path: companyPath(id), userID: userID, body: body}, &company); err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+func (c *Client) DeleteCompany(ctx context.Context, id string, userID string) error {
+	return c.do(ctx, call{method: http.MethodDelete, path: companyPath(id), userID: userID}, nil)
+}
+
+func (c *Client) GetUserCompanies(ctx context.Context, userID string) ([]Company, error) {
+	var companies list[Company]
+	if err := c.do(ctx, call{method: http.MethodGet, path: "/companies", query: userQuery(userID), userID: userID}, &companies); err != nil {
+		return nil, err
+	}
+	return companies.Data, nil
+}
+
+// GetCompanyTemplates lists the company types and what they seed
+func (c *Client) GetCompanyTemplates(ctx context.Context) ([]CompanyTemplate, error) {
+	var templates struct {
+		Templates []CompanyTemplate `json:"templates"`
+	}
+	if err := c.do(ctx, call{method: http.MethodGet, path: "/company-templates"}, &templates); err != nil {
+		return nil, err
+	}
+	return templates.Templates, nil
+}
+
+func (c *Client) GetCompanyRoles(ctx context.Context, companyID string, userID string) ([]CompanyRole, error) {
+	var roles struct {
+		Roles []CompanyRole `json:"roles"`
+	}
+	if err := c.do(ctx, call{method: http.MethodGet, path: companyPath(companyID, "/roles"), query: userQuery(userID), userID: userID}, &roles); err != nil {
+		return nil, err
+	}
+	return roles.Roles, nil
+}
+
+// Company members
+
+func (c *Client) GetCompanyMembers(ctx context.Context, companyID string, userID string) ([]CompanyMember, error) {
+	var members list[CompanyMember]
+	if err := c.do(ctx, call{method: http.MethodGet, path: companyPath(companyID, "/members"), query: userQuery(userID), userID: userID}, &members); err != nil {
+		return nil, err
+	}
+	return members.Data, nil
+}
+
+// AddCompanyMember adds an active member, with a starting rate when
+// compensation is not nil
+func (c *Client) AddCompanyMember(ctx context.Context, companyID, memberUserID, role string, compensation *Compensation, requestingUserID string) (*CompanyMember, error) {
+	body := struct {
+		UserID string `json:"userId"`
+		Role   string `json:"role"`
+		Compensation
+		RequestingUserID string `json:"requestingUserId"`
+	}{UserID: memberUserID, Role: role, RequestingUserID: requestingUserID}
+	if compensation != nil {
+		body.Compensation = *compensation
+	}
+
+	var member CompanyMember
+	if err := c.do(ctx, call{method: http.MethodPost, path: companyPath(companyID, "/members"), userID: requestingUserID, body: body}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (c *Client) RemoveCompanyMember(ctx context.Context, companyID, memberUserID, requestingUserID string) error {
+	path := companyPath(companyID, "/members/", url.PathEscape(memberUserID))
+	return c.do(ctx, call{method: http.MethodDelete, path: path, userID: requestingUserID}, nil)
+}
+
+func (c *Client) UpdateCompanyMemberRole(ctx context.Context, companyID, memberUserID, role, requestingUserID string) (*CompanyMember, error) {
+	body := struct {
+		RequestingUserID string `json:"requestingUserId"`
+		Role             string `json:"role"`
+	}{requestingUserID, role}
+
+	var member CompanyMember
+	path := companyPath(companyID, "/members/", url.PathEscape(memberUserID), "/role")
+	if err := c.do(ctx, call{method: http.MethodPut, path: path, userID: requestingUserID, body: body}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// Invitations
+
+// InviteCompanyMember creates a pending membership that the user accepts with
+// AcceptInvitation
+func (c *Client) InviteCompanyMember(ctx context.Context, companyID, memberUserID, role, requestingUserID string) (*CompanyMember, error) {
+	body := struct {
+		UserID           string `json:"userId"`
+		Role             string `json:"role"`
+		RequestingUserID string `json:"requestingUserId"`
+	}{memberUserID, role, requestingUserID}
+
+	var member CompanyMember
+	if err := c.do(ctx, call{method: http.MethodPost, path: companyPath(companyID, "/invitations"), userID: requestingUserID, body: body}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (c *Client) AcceptInvitation(ctx context.Context, companyID, userID string) (*CompanyMember, error) {
+	body := struct {
+		UserID string `json:"userId"`
+	}{userID}
+
+	var member CompanyMember
+	if err := c.do(ctx, call{method: http.MethodPost, path: companyPath(companyID, "/invitations/accept"), userID: userID, body: body}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// GetUserInvitations lists the user's pending invitations across companies
+func (c *Client) GetUserInvitations(ctx context.Context, userID string) ([]CompanyMember, error) {
+	var invitations list[CompanyMember]
+	if err := c.do(ctx, call{method: http.MethodGet, path: "/companies/invitations", query: userQuery(userID), userID: userID}, &invitations); err != nil {
+		return nil, err
+	}
+	return invitations.Data, nil
+}
+
+// Member compensation
+
+// SetMemberCompensation records a rate change effective from
+// compensation.EffectiveFrom, or now. Every call adds a rate to the history,
+// so it is not retried.
+func (c *Client) SetMemberCompensation(ctx context.Context, companyID, memberUserID string, compensation Compensation, requestingUserID string) (*CompensationRate, error) {
+	body := struct {
+		Compensation
+		RequestingUserID string `json:"requestingUserId"`
+	}{compensation, requestingUserID}
+
+	var rate CompensationRate
+	path := companyPath(companyID, "/members/", url.PathEscape(memberUserID), "/compensation")
+	if err := c.do(ctx, call{method: http.MethodPut, path: path, userID: requestingUserID, body: body, noRetry: true}, &rate); err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// GetMemberCompensationHistory lists a member's rates, newest first
+func (c *Client) GetMemberCompensationHistory(ctx context.Context, companyID, memberUserID, requestingUserID string) ([]CompensationRate, error) {
+	var rates list[CompensationRate]
+	path := companyPath(companyID, "/members/", url.PathEscape(memberUserID), "/compensation")
+	if err := c.do(ctx, call{method: http.MethodGet, path: path, query: userQuery(requestingUserID), userID: requestingUserID}, &rates); err != nil {
+		return nil, err
+	}
+	return rates.Data, nil
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Error codes returned by the service in the "code" field of error responses
+const (
+	CodeBadRequest           = "bad_request"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeNotFound             = "not_found"
+	CodeMethodNotAllowed     = "method_not_allowed"
+	CodeConflict             = "conflict"
+	CodeUnprocessableEntity  = "unprocessable_entity"
+	CodeTooManyRequests      = "too_many_requests"
+	CodeInternalError        = "internal_error"
+	CodeServiceUnavailable   = "service_unavailable"
+	CodeValidationFailed     = "validation_failed"
+	CodeDatabaseError        = "database_error"
+	CodeExternalServiceError = "external_service_error"
+)
+
+// Sentinel errors for errors.Is, one per error code
+var (
+	ErrBadRequest           = &APIError{Code: CodeBadRequest}
+	ErrUnauthorized         = &APIError{Code: CodeUnauthorized}
+	ErrForbidden            = &APIError{Code: CodeForbidden}
+	ErrNotFound             = &APIError{Code: CodeNotFound}
+	ErrMethodNotAllowed     = &APIError{Code: CodeMethodNotAllowed}
+	ErrConflict             = &APIError{Code: CodeConflict}
+	ErrUnprocessableEntity  = &APIError{Code: CodeUnprocessableEntity}
+	ErrTooManyRequests      = &APIError{Code: CodeTooManyRequests}
+	ErrInternalError        = &APIError{Code: CodeInternalError}
+	ErrServiceUnavailable   = &APIError{Code: CodeServiceUnavailable}
+	ErrValidationFailed     = &APIError{Code: CodeValidationFailed}
+	ErrDatabaseError        = &APIError{Code: CodeDatabaseError}
+	ErrExternalServiceError = &APIError{Code: CodeExternalServiceError}
+)
+
+// APIError is an error response from the service. Message carries the
+// service's error text, e.g. "user cannot access this project".
+type APIError struct {
+	StatusCode int             `json:"-"`
+	Code       string          `json:"code"`
+	Message    string          `json:"error"`
+	Details    string          `json:"details,omitempty"`
+	Path       string          `json:"path,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Metadata   json.RawMessage `json:"metadata,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Message
+}
+
+// Is matches sentinel errors on their code
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == e.Code
+}
+
+// Private helper methods
+
+// newAPIError decodes an error body. Responses that did not come from the
+// service, such as a proxy's 502 page, get the code matching their status.
+func newAPIError(status int, body []byte) *APIError {
+	apiErr := &APIError{}
+	if err := json.Unmarshal(body, apiErr); err != nil || apiErr.Code == "" {
+		apiErr = &APIError{Message: strings.TrimSpace(string(body))}
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(status)
+		}
+	}
+	apiErr.StatusCode = status
+	if apiErr.Code == "" {
+		apiErr.Code = codeForStatus(status)
+	}
+	return apiErr
+}
+
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return CodeExternalServiceError
+	}
+	return CodeInternalError
+}
@@ -0,0 +1,334 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateProject creates a project owned by input.OwnerID
+func (c *Client) CreateProject(ctx context.Context, input CreateProjectInput) (*Project, error) {
+	var project Project
+	if err := c.do(ctx, call{method: http.MethodPost, path: "/projects", userID: input.OwnerID, body: input}, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (c *Client) GetProject(ctx context.Context, id uint, userID string) (*Project, error) {
+	var project Project
+	if err := c.do(ctx, call{method: http.MethodGet, path: projectPath(id), userID: userID}, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (c *Client) UpdateProject(ctx context.Context, id uint, input UpdateProjectInput, userID string) (*Project, error) {
+	body := struct {
+		UpdateProjectInput
+		UserID string `json:"userId"`
+	}{input, userID}
+
+	var project Project
+	if err := c.do(ctx, call{method: http.MethodPut, path: projectPath(id), userID: userID, body: body}, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (c *Client) DeleteProject(ctx context.Context, id uint, userID string) error {
+	return c.do(ctx, call{method: http.MethodDelete, path: projectPath(id), userID: userID}, nil)
+}
+
+// GetUserProjects lists the projects the user owns, belongs to or reaches
+// through a team or company
+func (c *Client) GetUserProjects(ctx context.Context, userID string) ([]Project, error) {
+	return c.ListUserProjects(ctx, userID, ProjectFilter{})
+}
+
+// ListUserProjects is GetUserProjects limited to the projects matching filter
+func (c *Client) ListUserProjects(ctx context.Context, userID string, filter ProjectFilter) ([]Project, error) {
+	query := userQuery(userID)
+	for key, value := range filter.CustomFields {
+		query.Add("field", key+":"+value)
+	}
+	for _, tag := range filter.Tags {
+		query.Add("tag", tag)
+	}
+
+	var projects list[Project]
+	if err := c.do(ctx, call{method: http.MethodGet, path: "/projects", query: query, userID: userID}, &projects); err != nil {
+		return nil, err
+	}
+	return projects.Data, nil
+}
+
+// Project hierarchy
+
+func (c *Client) GetProjectChildren(ctx context.Context, id uint, userID string) ([]Project, error) {
+	var children list[Project]
+	if err := c.do(ctx, call{method: http.MethodGet, path: projectPath(id, "/children"), query: userQuery(userID), userID: userID}, &children); err != nil {
+		return nil, err
+	}
+	return children.Data, nil
+}
+
+func (c *Client) GetProjectTree(ctx context.Context, id uint, userID string) (*ProjectTree, error) {
+	var tree ProjectTree
+	if err := c.do(ctx, call{method: http.MethodGet, path: projectPath(id, "/tree"), query: userQuery(userID), userID: userID}, &tree); err != nil {
+		return nil, err
+	}
+	return &tree, nil
+}
+
+// MoveProject attaches the project under parentID, or detaches it to the top
+// level when parentID is nil
+func (c *Client) MoveProject(ctx context.Context, id uint, parentID *uint, userID string) (*Project, error) {
+	body := struct {
+		ParentID *uint  `json:"parentId"`
+		UserID   string `json:"userId"`
+	}{parentID, userID}
+
+	var project Project
+	if err := c.do(ctx, call{method: http.MethodPut, path: projectPath(id, "/parent"), userID: userID, body: body}, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// CloneProject copies a project, and its members when input.IncludeMembers is
+// set; the clone is owned by the caller
+func (c *Client) CloneProject(ctx context.Context, id uint, input CloneProjectInput, userID string) (*Project, []ProjectMember, error) {
+	body := struct {
+		CloneProjectInput
+		UserID string `json:"userId"`
+	}{input, userID}
+
+	var clone struct {
+		Project Project         `json:"project"`
+		Members []ProjectMember `json:"members"`
+	}
+	if err := c.do(ctx, call{method: http.MethodPost, path: projectPath(id, "/clone"), userID: userID, body: body}, &clone); err != nil {
+		return nil, nil, err
+	}
+	return &clone.Project, clone.Members, nil
+}
+
+// Bulk operations. Partial failures are reported per project in the result.
+// When an atomic batch is rolled back the result is returned together with an
+// error matching ErrUnprocessableEntity.
+
+func (c *Client) BulkUpdateStatus(ctx context.Context, projectIDs []uint, status string, atomic bool, userID string) (*BulkResult, error) {
+	body := struct {
+		bulkRequest
+		Status string `json:"status"`
+	}{bulkRequest{projectIDs, atomic, userID}, status}
+	return c.bulk(ctx, "/projects/bulk/status", body, userID)
+}
+
+func (c *Client) BulkReassignOwner(ctx context.Context, projectIDs []uint, newOwnerID string, atomic bool, userID string) (*BulkResult, error) {
+	body := struct {
+		bulkRequest
+		OwnerID string `json:"ownerId"`
+	}{bulkRequest{projectIDs, atomic, userID}, newOwnerID}
+	return c.bulk(ctx, "/projects/bulk/owner", body, userID)
+}
+
+// BulkMoveToCompany moves projects to companyID, or makes them personal when
+// it is nil
+func (c *Client) BulkMoveToCompany(ctx context.Context, projectIDs []uint, companyID *string, atomic bool, userID string) (*BulkResult, error) {
+	body := struct {
+		bulkRequest
+		CompanyID *string `json:"companyId"`
+	}{bulkRequest{projectIDs, atomic, userID}, companyID}
+	return c.bulk(ctx, "/projects/bulk/company", body, userID)
+}
+
+func (c *Client) BulkDelete(ctx context.Context, projectIDs []uint, atomic bool, userID string) (*BulkResult, error) {
+	return c.bulk(ctx, "/projects/bulk/delete", bulkRequest{projectIDs, atomic, userID}, userID)
+}
+
+func (c *Client) BulkAddMember(ctx context.Context, projectIDs []uint, memberUserID, role string, permissions []string, atomic bool, requestingUserID string) (*BulkResult, error) {
+	body := bulkMemberRequest{
+		bulkRequest:      bulkRequest{projectIDs, atomic, memberUserID},
+		Role:             role,
+		Permissions:      permissions,
+		RequestingUserID: requestingUserID,
+	}
+	return c.bulk(ctx, "/projects/bulk/members/add", body, requestingUserID)
+}
+
+func (c *Client) BulkRemoveMember(ctx context.Context, projectIDs []uint, memberUserID string, atomic bool, requestingUserID string) (*BulkResult, error) {
+	body := bulkMemberRequest{
+		bulkRequest:      bulkRequest{projectIDs, atomic, memberUserID},
+		RequestingUserID: requestingUserID,
+	}
+	return c.bulk(ctx, "/projects/bulk/members/remove", body, requestingUserID)
+}
+
+// Import and export
+
+// ExportProjects streams the caller's projects, or a company's, with their
+// members. The caller must close the returned reader.
+func (c *Client) ExportProjects(ctx context.Context, options ExportOptions, userID string) (io.ReadCloser, error) {
+	query := userQuery(userID)
+	if options.CompanyID != nil {
+		query.Set("companyId", *options.CompanyID)
+	}
+	if options.Format != "" {
+		query.Set("format", options.Format)
+	}
+
+	req := call{method: http.MethodGet, path: "/projects/export", query: query, userID: userID}
+	resp, err := c.send(ctx, req, func() io.Reader { return nil }, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, decode(resp, nil)
+	}
+	return resp.Body, nil
+}
+
+// ImportProjects upserts projects from a CSV file or JSON array. Rows that
+// fail are reported in the result; the call itself is never retried.
+func (c *Client) ImportProjects(ctx context.Context, file io.Reader, options ImportOptions, userID string) (*ImportResult, error) {
+	query := userQuery(userID)
+	if options.CompanyID != nil {
+		query.Set("companyId", *options.CompanyID)
+	}
+	if options.Format != "" {
+		query.Set("format", options.Format)
+	}
+	if options.DryRun {
+		query.Set("dryRun", "true")
+	}
+	contentType := "application/json"
+	if options.Format == "csv" {
+		contentType = "text/csv"
+	}
+
+	req := call{method: http.MethodPost, path: "/projects/import", query: query, userID: userID}
+	resp, err := c.send(ctx, req, func() io.Reader { return file }, contentType)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result ImportResult
+	if err := decode(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Project members
+
+func (c *Client) GetProjectMembers(ctx context.Context, projectID uint, userID string) ([]ProjectMember, error) {
+	var members struct {
+		Members []ProjectMember `json:"members"`
+	}
+	if err := c.do(ctx, call{method: http.MethodGet, path: projectPath(projectID, "/members"), query: userQuery(userID), userID: userID}, &members); err != nil {
+		return nil, err
+	}
+	return members.Members, nil
+}
+
+func (c *Client) AddProjectMember(ctx context.Context, projectID uint, memberUserID, role string, permissions []string, requestingUserID string) (*ProjectMember, error) {
+	body := struct {
+		UserID           string   `json:"userId"`
+		Role             string   `json:"role"`
+		Permissions      []string `json:"permissions"`
+		RequestingUserID string   `json:"requestingUserId"`
+	}{memberUserID, role, permissions, requestingUserID}
+
+	var member ProjectMember
+	if err := c.do(ctx, call{method: http.MethodPost, path: projectPath(projectID, "/members"), userID: requestingUserID, body: body}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// UpdateProjectMember changes a member's role and permissions; an empty role
+// or nil permissions keep the current value
+func (c *Client) UpdateProjectMember(ctx context.Context, projectID uint, memberUserID, role string, permissions []string, requestingUserID string) (*ProjectMember, error) {
+	body := struct {
+		RequestingUserID string   `json:"requestingUserId"`
+		Role             string   `json:"role,omitempty"`
+		Permissions      []string `json:"permissions,omitempty"`
+	}{requestingUserID, role, permissions}
+
+	var member ProjectMember
+	path := projectPath(projectID, "/members/", url.PathEscape(memberUserID))
+	if err := c.do(ctx, call{method: http.MethodPut, path: path, userID: requestingUserID, body: body}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// Project teams
+
+func (c *Client) GetProjectTeams(ctx context.Context, projectID uint, userID string) ([]ProjectTeam, error) {
+	var teams struct {
+		Teams []ProjectTeam `json:"teams"`
+	}
+	if err := c.do(ctx, call{method: http.MethodGet, path: projectPath(projectID, "/teams"), query: userQuery(userID), userID: userID}, &teams); err != nil {
+		return nil, err
+	}
+	return teams.Teams, nil
+}
+
+func (c *Client) AddProjectTeam(ctx context.Context, projectID, teamID uint, role string, permissions []string, requestingUserID string) (*ProjectTeam, error) {
+	body := struct {
+		TeamID           uint     `json:"teamId"`
+		Role             string   `json:"role"`
+		Permissions      []string `json:"permissions"`
+		RequestingUserID string   `json:"requestingUserId"`
+	}{teamID, role, permissions, requestingUserID}
+
+	var grant ProjectTeam
+	if err := c.do(ctx, call{method: http.MethodPost, path: projectPath(projectID, "/teams"), userID: requestingUserID, body: body}, &grant); err != nil {
+		return nil, err
+	}
+	return &grant, nil
+}
+
+func (c *Client) RemoveProjectTeam(ctx context.Context, projectID, teamID uint, requestingUserID string) error {
+	path := projectPath(projectID, "/teams/", strconv.FormatUint(uint64(teamID), 10))
+	return c.do(ctx, call{method: http.MethodDelete, path: path, userID: requestingUserID}, nil)
+}
+
+// Private helper methods
+
+type bulkRequest struct {
+	ProjectIDs []uint `json:"projectIds"`
+	Atomic     bool   `json:"atomic"`
+	UserID     string `json:"userId"`
+}
+
+type bulkMemberRequest struct {
+	bulkRequest
+	Role             string   `json:"role,omitempty"`
+	Permissions      []string `json:"permissions,omitempty"`
+	RequestingUserID string   `json:"requestingUserId"`
+}
+
+// bulk returns the per-project result, which a rolled back atomic batch
+// carries in the error metadata
+func (c *Client) bulk(ctx context.Context, path string, body any, userID string) (*BulkResult, error) {
+	var result BulkResult
+	err := c.do(ctx, call{method: http.MethodPost, path: path, userID: userID, body: body}, &result)
+	if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusUnprocessableEntity && len(apiErr.Metadata) > 0 {
+		if json.Unmarshal(apiErr.Metadata, &result) == nil {
+			return &result, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
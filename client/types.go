@@ -0,0 +1,224 @@
+package client
+
+import "time"
+
+// Project is a core project as returned by the service
+type Project struct {
+	ID          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description"`
+	Status      string     `json:"status"`
+	OwnerID     string     `json:"ownerId"`
+	CompanyID   *string    `json:"companyId"`
+	ParentID    *uint      `json:"parentId"`
+	StartDate   *time.Time `json:"startDate"`
+	EndDate     *time.Time `json:"endDate"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	ExternalKey *string    `json:"externalKey,omitempty"`
+	ProjectType *string    `json:"projectType,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+
+	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+}
+
+// ProjectTree is a project with its sub-projects and their roll-up
+type ProjectTree struct {
+	Project
+	Rollup   ProjectRollup `json:"rollup"`
+	Children []ProjectTree `json:"children"`
+}
+
+type ProjectRollup struct {
+	Status       string     `json:"status"`
+	StartDate    *time.Time `json:"startDate"`
+	EndDate      *time.Time `json:"endDate"`
+	ProjectCount int        `json:"projectCount"`
+}
+
+type ProjectMember struct {
+	ProjectID   string    `json:"projectId"`
+	ProjectType string    `json:"projectType"`
+	UserID      string    `json:"userId"`
+	Role        string    `json:"role"`
+	Permissions []string  `json:"permissions"`
+	JoinedAt    time.Time `json:"joinedAt"`
+}
+
+// ProjectTeam is a team's role on a project
+type ProjectTeam struct {
+	ID          uint      `json:"id"`
+	ProjectID   uint      `json:"projectId"`
+	TeamID      uint      `json:"teamId"`
+	Role        string    `json:"role"`
+	Permissions []string  `json:"permissions"`
+	GrantedBy   string    `json:"grantedBy"`
+	GrantedAt   time.Time `json:"grantedAt"`
+}
+
+// BulkResult reports each project of a bulk operation
+type BulkResult struct {
+	Results    []BulkItemResult `json:"results"`
+	Succeeded  int              `json:"succeeded"`
+	Failed     int              `json:"failed"`
+	Atomic     bool             `json:"atomic"`
+	RolledBack bool             `json:"rolledBack"`
+}
+
+type BulkItemResult struct {
+	ProjectID uint   `json:"projectId"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ImportResult reports each row of an import
+type ImportResult struct {
+	DryRun  bool              `json:"dryRun"`
+	Rows    []ImportRowResult `json:"rows"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+}
+
+type ImportRowResult struct {
+	Row         int    `json:"row"`
+	ExternalKey string `json:"externalKey,omitempty"`
+	Action      string `json:"action"`
+	ProjectID   *uint  `json:"projectId,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type Company struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	OwnerID string `json:"ownerId"`
+}
+
+// CompanyMember is a member or pending invitation; compensation is only
+// filled in for callers allowed to see it
+type CompanyMember struct {
+	ID         uint       `json:"id"`
+	CompanyID  string     `json:"companyId"`
+	UserID     string     `json:"userId"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	JoinedAt   *time.Time `json:"joinedAt"`
+	InvitedAt  time.Time  `json:"invitedAt"`
+	InvitedBy  string     `json:"invitedBy"`
+	Salary     *float64   `json:"salary,omitempty"`
+	HourlyRate *float64   `json:"hourlyRate,omitempty"`
+	Currency   string     `json:"currency,omitempty"`
+}
+
+type CompensationRate struct {
+	ID            uint      `json:"id"`
+	CompanyID     string    `json:"companyId"`
+	UserID        string    `json:"userId"`
+	Salary        *float64  `json:"salary,omitempty"`
+	HourlyRate    *float64  `json:"hourlyRate,omitempty"`
+	Currency      string    `json:"currency"`
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	SetBy         string    `json:"setBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type CompanyRole struct {
+	ID          uint     `json:"id"`
+	CompanyID   string   `json:"companyId"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// CompanyTemplate seeds roles and starter projects for a company type
+type CompanyTemplate struct {
+	Type            string                   `json:"type"`
+	Description     string                   `json:"description"`
+	Roles           []RoleTemplate           `json:"roles"`
+	StarterProjects []StarterProjectTemplate `json:"starterProjects"`
+}
+
+type RoleTemplate struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type StarterProjectTemplate struct {
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	DurationDays *int    `json:"durationDays"`
+}
+
+// Inputs
+
+type CreateProjectInput struct {
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	Status      string     `json:"status,omitempty"` // Defaults to "active"
+	OwnerID     string     `json:"ownerId"`
+	CompanyID   *string    `json:"companyId,omitempty"`
+	ParentID    *uint      `json:"parentId,omitempty"`
+	StartDate   *time.Time `json:"startDate,omitempty"`
+	EndDate     *time.Time `json:"endDate,omitempty"`
+	ProjectType *string    `json:"projectType,omitempty"` // Registered type of the module extending the project
+
+	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+}
+
+// UpdateProjectInput leaves empty fields unchanged
+type UpdateProjectInput struct {
+	Title       string     `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	StartDate   *time.Time `json:"startDate,omitempty"`
+	EndDate     *time.Time `json:"endDate,omitempty"`
+
+	CustomFields map[string]interface{} `json:"customFields,omitempty"` // Merged into the current values; a nil value clears a field
+}
+
+// ProjectFilter narrows ListUserProjects. The zero value matches every project.
+type ProjectFilter struct {
+	CustomFields map[string]string // Custom field key to value; multiselect fields match any selected option
+	Tags         []string          // Tag names, ignoring case; a project must have all of them
+}
+
+type CloneProjectInput struct {
+	Title          string     `json:"title,omitempty"` // Defaults to the source title
+	StartDate      *time.Time `json:"startDate,omitempty"`
+	IncludeMembers bool       `json:"includeMembers"`
+}
+
+// ExportOptions selects a company's projects instead of the caller's, and the
+// file format, "json" (default) or "csv"
+type ExportOptions struct {
+	CompanyID *string
+	Format    string
+}
+
+// ImportOptions mirrors ExportOptions; DryRun validates without writing
+type ImportOptions struct {
+	CompanyID *string
+	Format    string
+	DryRun    bool
+}
+
+type CreateCompanyInput struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	OwnerID string `json:"ownerId"`
+}
+
+// UpdateCompanyInput leaves empty fields unchanged
+type UpdateCompanyInput struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// Compensation is a salary or hourly rate; EffectiveFrom defaults to now
+type Compensation struct {
+	Salary        *float64   `json:"salary,omitempty"`
+	HourlyRate    *float64   `json:"hourlyRate,omitempty"`
+	Currency      string     `json:"currency,omitempty"`
+	EffectiveFrom *time.Time `json:"effectiveFrom,omitempty"`
+}
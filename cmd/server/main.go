@@ -1,14 +1,58 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/analytics"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/archive"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/calendar"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/collab"
 	"github.com/JorgeSaicoski/go-project-manager/internal/api/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/costs"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/customfields"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/email"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/events"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/graphql"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/notifications"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/openapi"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/privacy"
 	"github.com/JorgeSaicoski/go-project-manager/internal/api/projects"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/projecttypes"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/rpc"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/search"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/tags"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/teams"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/templates"
 	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	analyticsService "github.com/JorgeSaicoski/go-project-manager/internal/services/analytics"
+	archiveService "github.com/JorgeSaicoski/go-project-manager/internal/services/archive"
+	calendarService "github.com/JorgeSaicoski/go-project-manager/internal/services/calendar"
+	collabService "github.com/JorgeSaicoski/go-project-manager/internal/services/collab"
 	companiesService "github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	costsService "github.com/JorgeSaicoski/go-project-manager/internal/services/costs"
+	customfieldsService "github.com/JorgeSaicoski/go-project-manager/internal/services/customfields"
+	emailService "github.com/JorgeSaicoski/go-project-manager/internal/services/email"
+	eventsService "github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	graphService "github.com/JorgeSaicoski/go-project-manager/internal/services/graph"
+	notificationsService "github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+	privacyService "github.com/JorgeSaicoski/go-project-manager/internal/services/privacy"
 	projectsService "github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	projecttypesService "github.com/JorgeSaicoski/go-project-manager/internal/services/projecttypes"
+	pubsubService "github.com/JorgeSaicoski/go-project-manager/internal/services/pubsub"
+	schedulerService "github.com/JorgeSaicoski/go-project-manager/internal/services/scheduler"
+	searchService "github.com/JorgeSaicoski/go-project-manager/internal/services/search"
+	tagsService "github.com/JorgeSaicoski/go-project-manager/internal/services/tags"
+	teamsService "github.com/JorgeSaicoski/go-project-manager/internal/services/teams"
+	templatesService "github.com/JorgeSaicoski/go-project-manager/internal/services/templates"
 	"github.com/JorgeSaicoski/microservice-commons/config"
 	"github.com/JorgeSaicoski/microservice-commons/database"
 	"github.com/JorgeSaicoski/microservice-commons/server"
+	"github.com/JorgeSaicoski/microservice-commons/utils"
+	"github.com/JorgeSaicoski/pgconnect"
 	"github.com/gin-gonic/gin"
 )
 
@@ -29,16 +73,195 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 	}
 
 	// Auto-migrate models
-	if err := database.QuickMigrate(dbConnection, &db.BaseProject{}, &db.ProjectMember{}, &db.Company{}, &db.CompanyMember{}); err != nil {
+	if err := database.QuickMigrate(dbConnection, &db.BaseProject{}, &db.ProjectMember{}, &db.ProjectType{}, &db.CustomFieldDefinition{}, &db.Company{}, &db.CompanyMember{}, &db.Team{}, &db.TeamMember{}, &db.ProjectTeam{}, &db.Tag{}, &db.ProjectTag{}, &db.ProjectTemplate{}, &db.ProjectTemplateMember{}, &db.CompanyRole{}, &db.CompensationRate{}, &db.ErasureRecord{}, &db.CalendarToken{}, &db.Notification{}, &db.NotificationPreference{}, &db.EmailSettings{}, &db.EmailMessage{}, &db.ChangeEvent{}, &db.ProjectPresence{}); err != nil {
 		panic("Failed to migrate database: " + err.Error())
 	}
 
+	// Full-text search columns and indexes are not expressible as model tags
+	if err := searchService.Migrate(dbConnection); err != nil {
+		panic("Failed to migrate search indexes: " + err.Error())
+	}
+
+	// Load company templates, with custom templates from configuration files
+	companyTemplates := companiesService.NewTemplateRegistry()
+	if dir := utils.GetEnv("COMPANY_TEMPLATES_DIR", ""); dir != "" {
+		if err := companyTemplates.LoadDir(dir); err != nil {
+			panic("Failed to load company templates: " + err.Error())
+		}
+	}
+
 	// Initialize services
-	projectService := projectsService.NewProjectService(dbConnection)
-	companyService := companiesService.NewCompanyService(dbConnection)
+	pubSub := pubsubService.NewPubSub(dbConnection)
+	notificationSvc := notificationsService.NewNotificationService(dbConnection)
+	eventSvc := eventsService.NewEventService(dbConnection, pubSub)
+	projectService := projectsService.NewProjectService(dbConnection, notificationSvc, eventSvc)
+	companyService := companiesService.NewCompanyService(dbConnection, companyTemplates, notificationSvc, eventSvc)
+	teamService := teamsService.NewTeamService(dbConnection, eventSvc)
+	projectTypeService := projecttypesService.NewProjectTypeService(dbConnection)
+	customFieldService := customfieldsService.NewCustomFieldService(dbConnection)
+	tagService := tagsService.NewTagService(dbConnection, projectService)
+	templateService := templatesService.NewTemplateService(dbConnection, projectService)
+	analyticsSvc := analyticsService.NewAnalyticsService(dbConnection)
+	costSvc := costsService.NewCostService(dbConnection)
+	searchSvc := searchService.NewSearchService(dbConnection)
+	archiveSvc := archiveService.NewArchiveService(dbConnection)
+	privacySvc := privacyService.NewPrivacyService(dbConnection)
+	graphSvc := graphService.NewGraphService(dbConnection)
+	calendarSvc := calendarService.NewCalendarService(dbConnection, projectService, utils.GetEnv("CALENDAR_UID_DOMAIN", "project-core"))
+	collabHub, err := collabService.NewHub(dbConnection, eventSvc, pubSub, projectService)
+	if err != nil {
+		panic("Failed to set up collaboration hub: " + err.Error())
+	}
+
+	// Email is delivered only when a transport is configured
+	var emailSvc *emailService.EmailService
+	if transport := utils.GetEnv("EMAIL_TRANSPORT", ""); transport != "" {
+		emailSvc = newEmailService(dbConnection, transport)
+		notificationSvc.AddChannel(emailSvc)
+	}
+
+	// Replicas share changes and presence through LISTEN/NOTIFY, with polling
+	// for notifications lost while reconnecting
+	pollInterval, err := time.ParseDuration(utils.GetEnv("EVENTS_POLL_INTERVAL", eventsService.DefaultPollInterval.String()))
+	if err != nil || pollInterval <= 0 {
+		panic("Invalid EVENTS_POLL_INTERVAL")
+	}
+	pubSub.Start(context.Background())
+	go eventSvc.Watch(context.Background(), pollInterval)
+	collabHub.Start(context.Background())
+
+	// Background jobs; replicas coordinate through database locks
+	if utils.GetEnv("SCHEDULER_ENABLED", "true") == "true" {
+		startScheduler(dbConnection, notificationSvc, emailSvc, eventSvc)
+	}
+
+	// OpenAPI contract for the projects and companies routes
+	apiDoc, err := openapi.NewDocument()
+	if err != nil {
+		panic("Failed to build OpenAPI document: " + err.Error())
+	}
+	validationMode, err := openapi.ParseValidationMode(utils.GetEnv("OPENAPI_VALIDATION", openapi.ValidationOff))
+	if err != nil {
+		panic("Invalid OPENAPI_VALIDATION")
+	}
 
 	// Setup routes
 	api := router.Group("/api")
+	if validationMode != openapi.ValidationOff {
+		validator, err := openapi.ValidationMiddleware(apiDoc, validationMode)
+		if err != nil {
+			panic("Failed to set up OpenAPI validation: " + err.Error())
+		}
+		api.Use(validator)
+	}
 	projects.RegisterRoutes(api, projectService)
 	companies.RegisterRoutes(api, companyService)
+	teams.RegisterRoutes(api, teamService)
+	projecttypes.RegisterRoutes(api, projectTypeService)
+	customfields.RegisterRoutes(api, customFieldService)
+	tags.RegisterRoutes(api, tagService)
+	templates.RegisterRoutes(api, templateService)
+	analytics.RegisterRoutes(api, analyticsSvc)
+	costs.RegisterRoutes(api, costSvc)
+	search.RegisterRoutes(api, searchSvc)
+	archive.RegisterRoutes(api, archiveSvc)
+	privacy.RegisterRoutes(api, privacySvc)
+	calendar.RegisterRoutes(api, calendarSvc)
+	notifications.RegisterRoutes(api, notificationSvc)
+	events.RegisterRoutes(api, eventSvc, projectService)
+	collab.RegisterRoutes(api, collabHub)
+	graphql.RegisterRoutes(api, graphSvc, projectService, graphql.Limits{
+		MaxComplexity: utils.GetEnvInt("GRAPHQL_MAX_COMPLEXITY", graphql.DefaultMaxComplexity),
+		MaxDepth:      utils.GetEnvInt("GRAPHQL_MAX_DEPTH", graphql.DefaultMaxDepth),
+	})
+	if emailSvc != nil {
+		email.RegisterRoutes(api, emailSvc)
+	}
+	openapi.RegisterRoutes(api, apiDoc)
+
+	// A route added or removed without updating the document stops startup
+	if err := openapi.CheckRoutes(router.Routes()); err != nil {
+		panic("OpenAPI document is out of date: " + err.Error())
+	}
+
+	// gRPC API for the specialized modules, on its own port
+	if utils.GetEnv("GRPC_ENABLED", "true") == "true" {
+		startGRPCServer(projectService, companyService)
+	}
+}
+
+func startGRPCServer(projectService *projectsService.ProjectService, companyService *companiesService.CompanyService) {
+	defaultTimeout, err := time.ParseDuration(utils.GetEnv("GRPC_DEFAULT_TIMEOUT", rpc.DefaultTimeout.String()))
+	if err != nil || defaultTimeout <= 0 {
+		panic("Invalid GRPC_DEFAULT_TIMEOUT")
+	}
+
+	grpcServer := rpc.NewServer(projectService, companyService, defaultTimeout)
+	addr := ":" + utils.GetEnv("GRPC_PORT", "9090")
+
+	// In-flight calls finish when the process is asked to stop
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		// The server outlives this function, so the signal handler is released when it returns
+		defer stop()
+		if err := rpc.Serve(ctx, grpcServer, addr); err != nil {
+			panic("gRPC server failed: " + err.Error())
+		}
+	}()
+}
+
+func newEmailService(dbConnection *pgconnect.DB, transportName string) *emailService.EmailService {
+	transport, err := emailService.NewTransport(
+		transportName,
+		utils.GetEnv("EMAIL_FROM", "Project Core <noreply@localhost>"),
+		utils.GetEnv("SMTP_ADDR", ""),
+		utils.GetEnv("SMTP_USERNAME", ""),
+		utils.GetEnv("SMTP_PASSWORD", ""),
+		utils.GetEnv("EMAIL_FILE_DIR", "mail"),
+	)
+	if err != nil {
+		panic("Invalid email transport: " + err.Error())
+	}
+
+	emailSvc, err := emailService.NewEmailService(dbConnection, transport, emailService.Config{
+		AppURL:        utils.GetEnv("EMAIL_APP_URL", ""),
+		PublicURL:     utils.GetEnv("EMAIL_PUBLIC_URL", "http://localhost:8080/api"),
+		Secret:        []byte(utils.GetEnv("EMAIL_SECRET", "")),
+		DefaultLocale: utils.GetEnv("EMAIL_DEFAULT_LOCALE", "en"),
+	})
+	if err != nil {
+		panic("Failed to set up email: " + err.Error())
+	}
+	return emailSvc
+}
+
+func startScheduler(dbConnection *pgconnect.DB, notificationSvc *notificationsService.NotificationService, emailSvc *emailService.EmailService, eventSvc *eventsService.EventService) {
+	interval, err := time.ParseDuration(utils.GetEnv("SCHEDULER_INTERVAL", "15m"))
+	if err != nil || interval <= 0 {
+		panic("Invalid SCHEDULER_INTERVAL")
+	}
+	leadTimes, err := schedulerService.ParseLeadTimes(utils.GetEnv("DEADLINE_LEAD_TIMES", schedulerService.DefaultLeadTimes))
+	if err != nil {
+		panic("Invalid DEADLINE_LEAD_TIMES: " + err.Error())
+	}
+
+	eventRetention, err := time.ParseDuration(utils.GetEnv("EVENT_RETENTION", eventsService.DefaultRetention.String()))
+	if err != nil || eventRetention <= 0 {
+		panic("Invalid EVENT_RETENTION")
+	}
+
+	scheduler := schedulerService.NewScheduler(dbConnection)
+	scheduler.Add(schedulerService.NewDeadlineJob(dbConnection, notificationSvc, leadTimes).Job(interval))
+	scheduler.Add(schedulerService.Job{Name: "events-prune", Interval: time.Hour, Run: func(ctx context.Context) error {
+		_, err := eventSvc.Prune(ctx, eventRetention)
+		return err
+	}})
+	if emailSvc != nil {
+		queueInterval, err := time.ParseDuration(utils.GetEnv("EMAIL_QUEUE_INTERVAL", "1m"))
+		if err != nil || queueInterval <= 0 {
+			panic("Invalid EMAIL_QUEUE_INTERVAL")
+		}
+		scheduler.Add(schedulerService.Job{Name: "email-queue", Interval: queueInterval, Run: emailSvc.ProcessQueue})
+	}
+	scheduler.Start(context.Background())
 }
@@ -0,0 +1,72 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/analytics"
+)
+
+// Response DTOs
+type MemberProjectCountResponse struct {
+	UserID       string `json:"userId"`
+	Role         string `json:"role"`
+	ProjectCount int64  `json:"projectCount"`
+}
+
+type TrendPointResponse struct {
+	Period time.Time `json:"period"`
+	Count  int64     `json:"count"`
+}
+
+type CompanyAnalyticsResponse struct {
+	CompanyID         string                       `json:"companyId"`
+	ProjectsByStatus  map[string]int64             `json:"projectsByStatus"`
+	OverdueProjects   int64                        `json:"overdueProjects"`
+	MembersByRole     map[string]int64             `json:"membersByRole"`
+	MembersByStatus   map[string]int64             `json:"membersByStatus"`
+	ProjectsPerMember []MemberProjectCountResponse `json:"projectsPerMember"`
+	Trends            TrendsResponse               `json:"trends"`
+	GeneratedAt       time.Time                    `json:"generatedAt"`
+}
+
+type TrendsResponse struct {
+	Interval  string               `json:"interval"`
+	Since     time.Time            `json:"since"`
+	Created   []TrendPointResponse `json:"created"`
+	Completed []TrendPointResponse `json:"completed"`
+}
+
+func trendToResponse(points []analytics.TrendPoint) []TrendPointResponse {
+	responses := make([]TrendPointResponse, len(points))
+	for i, point := range points {
+		responses[i] = TrendPointResponse{Period: point.Period, Count: point.Count}
+	}
+	return responses
+}
+
+func CompanyAnalyticsToResponse(result *analytics.CompanyAnalytics) CompanyAnalyticsResponse {
+	members := make([]MemberProjectCountResponse, len(result.ProjectsPerMember))
+	for i, member := range result.ProjectsPerMember {
+		members[i] = MemberProjectCountResponse{
+			UserID:       member.UserID,
+			Role:         member.Role,
+			ProjectCount: member.ProjectCount,
+		}
+	}
+
+	return CompanyAnalyticsResponse{
+		CompanyID:         result.CompanyID,
+		ProjectsByStatus:  result.ProjectsByStatus,
+		OverdueProjects:   result.OverdueProjects,
+		MembersByRole:     result.MembersByRole,
+		MembersByStatus:   result.MembersByStatus,
+		ProjectsPerMember: members,
+		Trends: TrendsResponse{
+			Interval:  result.Interval,
+			Since:     result.Since,
+			Created:   trendToResponse(result.Created),
+			Completed: trendToResponse(result.Completed),
+		},
+		GeneratedAt: result.GeneratedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/analytics"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+type AnalyticsHandler struct {
+	analyticsService *analytics.AnalyticsService
+}
+
+func NewAnalyticsHandler(analyticsService *analytics.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+func (h *AnalyticsHandler) GetCompanyAnalytics(c *gin.Context) {
+	companyID := c.Param("id")
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	options := analytics.TrendOptions{Interval: c.Query("interval")}
+	if since := c.Query("since"); since != "" {
+		parsed, err := time.Parse(time.DateOnly, since)
+		if err != nil {
+			parsed, err = time.Parse(time.RFC3339, since)
+		}
+		if err != nil {
+			responses.BadRequest(c, "Invalid since date")
+			return
+		}
+		options.Since = parsed
+	}
+
+	result, err := h.analyticsService.GetCompanyAnalytics(companyID, userID, options)
+	if err != nil {
+		if err.Error() == "user cannot view analytics for this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "invalid trend interval" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := CompanyAnalyticsToResponse(result)
+	responses.Success(c, "Company analytics retrieved successfully", response)
+}
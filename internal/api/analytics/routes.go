@@ -0,0 +1,17 @@
+package analytics
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/analytics"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers all analytics routes
+func RegisterRoutes(router *gin.RouterGroup, analyticsService *analytics.AnalyticsService) {
+	handler := NewAnalyticsHandler(analyticsService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/companies")
+	{
+		internal.GET("/:id/analytics", handler.GetCompanyAnalytics) // Company statistics (query: userId, since, interval)
+	}
+}
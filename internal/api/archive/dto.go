@@ -0,0 +1,26 @@
+package archive
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/archive"
+)
+
+// Response DTOs
+type ImportResponse struct {
+	CompanyID  string         `json:"companyId"`
+	Counts     map[string]int `json:"counts"`
+	ProjectIDs map[uint]uint  `json:"projectIds"` // Archived ID to new ID
+	TeamIDs    map[uint]uint  `json:"teamIds"`
+	MemberIDs  map[uint]uint  `json:"memberIds"`
+	TagIDs     map[uint]uint  `json:"tagIds"`
+}
+
+func ImportResultToResponse(result *archive.ImportResult) ImportResponse {
+	return ImportResponse{
+		CompanyID:  result.CompanyID,
+		Counts:     result.Counts,
+		ProjectIDs: result.ProjectIDs,
+		TeamIDs:    result.TeamIDs,
+		MemberIDs:  result.MemberIDs,
+		TagIDs:     result.TagIDs,
+	}
+}
@@ -0,0 +1,111 @@
+package archive
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/archive"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+// MaxArchiveBytes caps the size of an uploaded archive
+const MaxArchiveBytes = 1 << 30
+
+type ArchiveHandler struct {
+	archiveService *archive.ArchiveService
+}
+
+func NewArchiveHandler(archiveService *archive.ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{
+		archiveService: archiveService,
+	}
+}
+
+func (h *ArchiveHandler) ExportCompany(c *gin.Context) {
+	companyID := c.Param("id")
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", `attachment; filename="company-`+companyID+`.zip"`)
+
+	if err := h.archiveService.ExportCompany(companyID, userID, c.Writer); err != nil {
+		// Once the archive is streaming the status is sent and the error can only end the body
+		if c.Writer.Written() {
+			c.Error(err)
+			return
+		}
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		if err.Error() == "only company owner can export company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "record not found" {
+			responses.NotFound(c, "Company not found")
+			return
+		}
+		responses.InternalError(c, err.Error())
+	}
+}
+
+// ImportCompany restores an archive sent as the request body. The body is
+// spooled to a temporary file because zip needs random access.
+func (h *ArchiveHandler) ImportCompany(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	file, err := os.CreateTemp("", "company-archive-*.zip")
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	size, err := io.Copy(file, http.MaxBytesReader(c.Writer, c.Request.Body, MaxArchiveBytes))
+	if err != nil {
+		responses.BadRequest(c, "Could not read archive: "+err.Error())
+		return
+	}
+
+	options := archive.ImportOptions{CompanyID: c.Query("companyId")}
+	result, err := h.archiveService.ImportCompany(file, size, options, userID)
+	if err != nil {
+		if err.Error() == "only company owner can import company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "company already exists" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		if strings.HasPrefix(err.Error(), "invalid archive") || strings.HasPrefix(err.Error(), "unsupported archive version") {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := ImportResultToResponse(result)
+	responses.Created(c, "Company imported successfully", response)
+}
@@ -0,0 +1,18 @@
+package archive
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/archive"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers company archive routes
+func RegisterRoutes(router *gin.RouterGroup, archiveService *archive.ArchiveService) {
+	handler := NewArchiveHandler(archiveService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/companies")
+	{
+		internal.GET("/:id/archive", handler.ExportCompany) // Download a zip archive of the company (query: userId)
+		internal.POST("/import", handler.ImportCompany)     // Restore an archive into a new company (query: userId, companyId)
+	}
+}
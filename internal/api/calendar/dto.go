@@ -0,0 +1,51 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// Request DTOs
+type CreateTokenRequest struct {
+	UserID    string  `json:"userId" binding:"required"`
+	CompanyID *string `json:"companyId"` // Omit for a feed of the user's own projects
+	Name      string  `json:"name"`
+}
+
+// Response DTOs
+type CalendarTokenResponse struct {
+	ID         uint       `json:"id"`
+	UserID     string     `json:"userId"`
+	CompanyID  *string    `json:"companyId,omitempty"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+}
+
+type CreatedTokenResponse struct {
+	CalendarTokenResponse
+	Token   string `json:"token"`   // Only returned once
+	FeedURL string `json:"feedUrl"` // Path of the subscription URL
+}
+
+func TokenToResponse(token *db.CalendarToken) CalendarTokenResponse {
+	return CalendarTokenResponse{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		CompanyID:  token.CompanyID,
+		Name:       token.Name,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+	}
+}
+
+func TokensToResponse(tokens []db.CalendarToken) []CalendarTokenResponse {
+	responses := make([]CalendarTokenResponse, len(tokens))
+	for i, token := range tokens {
+		responses[i] = TokenToResponse(&token)
+	}
+	return responses
+}
@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/calendar"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type CalendarHandler struct {
+	calendarService *calendar.CalendarService
+	feedBasePath    string
+}
+
+func NewCalendarHandler(calendarService *calendar.CalendarService, feedBasePath string) *CalendarHandler {
+	return &CalendarHandler{
+		calendarService: calendarService,
+		feedBasePath:    feedBasePath,
+	}
+}
+
+func (h *CalendarHandler) CreateToken(c *gin.Context) {
+	var req CreateTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	calendarToken, token, err := h.calendarService.CreateToken(req.UserID, req.CompanyID, req.Name)
+	if err != nil {
+		if err.Error() == "user cannot access this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := CreatedTokenResponse{
+		CalendarTokenResponse: TokenToResponse(calendarToken),
+		Token:                 token,
+		FeedURL:               h.feedBasePath + "/" + token + ".ics",
+	}
+	responses.Created(c, "Calendar token created successfully", response)
+}
+
+func (h *CalendarHandler) GetUserTokens(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	tokens, err := h.calendarService.GetUserTokens(userID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	tokenResponses := TokensToResponse(tokens)
+	response := types.ListResponse[CalendarTokenResponse]{
+		Data: tokenResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(tokenResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Calendar tokens retrieved successfully", response)
+}
+
+func (h *CalendarHandler) RevokeToken(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid token ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	if err := h.calendarService.RevokeToken(uint(id), userID); err != nil {
+		if err.Error() == "user cannot revoke this token" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "record not found" {
+			responses.NotFound(c, "Calendar token not found")
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Calendar token revoked successfully", nil)
+}
+
+// GetFeed serves the ICS feed. The token in the URL is the only credential,
+// as calendar clients cannot send headers.
+func (h *CalendarHandler) GetFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Cache-Control", "private, max-age=900")
+
+	if err := h.calendarService.WriteFeed(token, c.Query("style"), c.Writer); err != nil {
+		if c.Writer.Written() {
+			c.Error(err)
+			return
+		}
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Cache-Control")
+		if err.Error() == "invalid calendar token" {
+			responses.NotFound(c, "Calendar not found")
+			return
+		}
+		if err.Error() == "invalid calendar style" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+	}
+}
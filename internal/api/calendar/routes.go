@@ -0,0 +1,24 @@
+package calendar
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/calendar"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers calendar token and ICS feed routes
+func RegisterRoutes(router *gin.RouterGroup, calendarService *calendar.CalendarService) {
+	// Subscription URLs are fetched by calendar clients and authenticated by their token
+	feeds := router.Group("/calendar")
+	handler := NewCalendarHandler(calendarService, feeds.BasePath())
+	{
+		feeds.GET("/:token", handler.GetFeed) // ICS feed, e.g. /calendar/{token}.ics (query: style=milestones|span)
+	}
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/calendar")
+	{
+		internal.POST("/tokens", handler.CreateToken)       // Create a feed token for a user or company
+		internal.GET("/tokens", handler.GetUserTokens)      // List a user's feed tokens (query: userId)
+		internal.DELETE("/tokens/:id", handler.RevokeToken) // Revoke a feed token (query: userId)
+	}
+}
@@ -0,0 +1,9 @@
+package collab
+
+// Request DTOs
+
+// ClientMessage is what clients send over the connection
+type ClientMessage struct {
+	Type      string `json:"type"` // subscribe, unsubscribe, ping
+	ProjectID uint   `json:"projectId"`
+}
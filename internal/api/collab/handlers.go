@@ -0,0 +1,145 @@
+package collab
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/collab"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// Connection settings
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = pongWait * 9 / 10 // Must be shorter than pongWait
+	maxMessageSize = 4096
+)
+
+var upgrader = websocket.Upgrader{
+	HandshakeTimeout: writeWait,
+	ReadBufferSize:   1024,
+	WriteBufferSize:  1024,
+	// Internal API: callers are other services or the gateway, which checks origins
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type CollabHandler struct {
+	hub *collab.Hub
+}
+
+func NewCollabHandler(hub *collab.Hub) *CollabHandler {
+	return &CollabHandler{
+		hub: hub,
+	}
+}
+
+func (h *CollabHandler) Connect(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	// Projects to subscribe on connect
+	values := c.QueryArray("projectId")
+	if id := c.Param("id"); id != "" {
+		values = append([]string{id}, values...)
+	}
+	projectIDs := make([]uint, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			responses.BadRequest(c, "Invalid project ID")
+			return
+		}
+		projectIDs = append(projectIDs, uint(id))
+	}
+
+	// Upgrade writes its own error response
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	client := h.hub.Connect(userID)
+	defer h.hub.Disconnect(client)
+	go writeMessages(conn, client)
+
+	for _, projectID := range projectIDs {
+		h.subscribe(client, projectID)
+	}
+
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message ClientMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			h.hub.Send(client, collab.Message{Type: collab.TypeError, Error: "Invalid message"})
+			continue
+		}
+
+		switch message.Type {
+		case "subscribe":
+			h.subscribe(client, message.ProjectID)
+		case "unsubscribe":
+			h.hub.Unsubscribe(client, message.ProjectID)
+		case "ping":
+			h.hub.Send(client, collab.Message{Type: collab.TypePong})
+		default:
+			h.hub.Send(client, collab.Message{Type: collab.TypeError, Error: "Unknown message type"})
+		}
+	}
+}
+
+func (h *CollabHandler) subscribe(client *collab.Client, projectID uint) {
+	if err := h.hub.Subscribe(client, projectID); err != nil {
+		h.hub.Send(client, collab.Message{Type: collab.TypeError, ProjectID: projectID, Error: err.Error()})
+	}
+}
+
+// writeMessages is the only writer of the connection. It closes the
+// connection when the client is dropped or a write fails, which also ends
+// the read loop.
+func writeMessages(conn *websocket.Conn, client *collab.Client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	defer conn.Close()
+
+	for {
+		select {
+		case message := <-client.Send():
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteJSON(message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-client.Done():
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "connection closed by server"),
+				time.Now().Add(writeWait))
+			return
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package collab
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/collab"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the real-time collaboration WebSocket
+func RegisterRoutes(router *gin.RouterGroup, hub *collab.Hub) {
+	handler := NewCollabHandler(hub)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/collab")
+	{
+		internal.GET("/ws", handler.Connect) // WebSocket for project updates and presence (query: userId, projectId repeated)
+	}
+
+	// Per-project entry point, subscribed to the project on connect
+	router.GET("/internal/projects/:id/ws", handler.Connect) // WebSocket for one project; more can be subscribed over it (query: userId)
+}
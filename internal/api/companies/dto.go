@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
 	"github.com/JorgeSaicoski/microservice-commons/types"
 )
 
@@ -21,10 +22,35 @@ type UpdateCompanyRequest struct {
 }
 
 type AddMemberRequest struct {
-	UserID     string   `json:"userId" binding:"required"`
-	Role       string   `json:"role" binding:"required"`
-	Salary     *float64 `json:"salary,omitempty"`
-	HourlyRate *float64 `json:"hourlyRate,omitempty"`
+	UserID        string     `json:"userId" binding:"required"`
+	Role          string     `json:"role" binding:"required"`
+	Salary        *float64   `json:"salary,omitempty"`
+	HourlyRate    *float64   `json:"hourlyRate,omitempty"`
+	Currency      string     `json:"currency,omitempty"`
+	EffectiveFrom *time.Time `json:"effectiveFrom,omitempty"`
+}
+
+type SetCompensationRequest struct {
+	RequestingUserID string     `json:"requestingUserId" binding:"required"`
+	Salary           *float64   `json:"salary"`
+	HourlyRate       *float64   `json:"hourlyRate"`
+	Currency         string     `json:"currency"`
+	EffectiveFrom    *time.Time `json:"effectiveFrom"` // Defaults to now
+}
+
+type UpdateMemberRoleRequest struct {
+	RequestingUserID string `json:"requestingUserId" binding:"required"`
+	Role             string `json:"role" binding:"required"`
+}
+
+type InviteMemberRequest struct {
+	UserID           string `json:"userId" binding:"required"`
+	Role             string `json:"role" binding:"required"`
+	RequestingUserID string `json:"requestingUserId" binding:"required"`
+}
+
+type AcceptInvitationRequest struct {
+	UserID string `json:"userId" binding:"required"`
 }
 
 type InternalCreateCompanyRequest struct {
@@ -53,13 +79,61 @@ type CompanyMemberResponse struct {
 	InvitedBy  string     `json:"invitedBy"`
 	Salary     *float64   `json:"salary,omitempty"`
 	HourlyRate *float64   `json:"hourlyRate,omitempty"`
+	Currency   string     `json:"currency,omitempty"`
+}
+
+type CompensationRateResponse struct {
+	ID            uint      `json:"id"`
+	CompanyID     string    `json:"companyId"`
+	UserID        string    `json:"userId"`
+	Salary        *float64  `json:"salary,omitempty"`
+	HourlyRate    *float64  `json:"hourlyRate,omitempty"`
+	Currency      string    `json:"currency"`
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	SetBy         string    `json:"setBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type CompanyRoleResponse struct {
+	ID          uint     `json:"id"`
+	CompanyID   string   `json:"companyId"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
 }
 
 // Use standardized list responses
 type CompanyListResponse = types.ListResponse[CompanyResponse]
 type MemberListResponse = types.ListResponse[CompanyMemberResponse]
+type CompensationListResponse = types.ListResponse[CompensationRateResponse]
 
 // Conversion methods remain the same
+func (r *AddMemberRequest) ToCompensation() *companies.Compensation {
+	if r.Salary == nil && r.HourlyRate == nil {
+		return nil
+	}
+	compensation := &companies.Compensation{
+		Salary:     r.Salary,
+		HourlyRate: r.HourlyRate,
+		Currency:   r.Currency,
+	}
+	if r.EffectiveFrom != nil {
+		compensation.EffectiveFrom = *r.EffectiveFrom
+	}
+	return compensation
+}
+
+func (r *SetCompensationRequest) ToCompensation() companies.Compensation {
+	compensation := companies.Compensation{
+		Salary:     r.Salary,
+		HourlyRate: r.HourlyRate,
+		Currency:   r.Currency,
+	}
+	if r.EffectiveFrom != nil {
+		compensation.EffectiveFrom = *r.EffectiveFrom
+	}
+	return compensation
+}
+
 func (r *CreateCompanyRequest) ToCompany() *db.Company {
 	return &db.Company{
 		ID:      r.ID,
@@ -107,6 +181,7 @@ func MemberToResponse(member *db.CompanyMember) CompanyMemberResponse {
 		InvitedBy:  member.InvitedBy,
 		Salary:     member.Salary,
 		HourlyRate: member.HourlyRate,
+		Currency:   member.Currency,
 	}
 }
 
@@ -117,3 +192,42 @@ func MembersToResponse(members []db.CompanyMember) []CompanyMemberResponse {
 	}
 	return responses
 }
+
+func RoleToResponse(role *db.CompanyRole) CompanyRoleResponse {
+	return CompanyRoleResponse{
+		ID:          role.ID,
+		CompanyID:   role.CompanyID,
+		Name:        role.Name,
+		Permissions: role.Permissions,
+	}
+}
+
+func RolesToResponse(roles []db.CompanyRole) []CompanyRoleResponse {
+	responses := make([]CompanyRoleResponse, len(roles))
+	for i, role := range roles {
+		responses[i] = RoleToResponse(&role)
+	}
+	return responses
+}
+
+func CompensationRateToResponse(rate *db.CompensationRate) CompensationRateResponse {
+	return CompensationRateResponse{
+		ID:            rate.ID,
+		CompanyID:     rate.CompanyID,
+		UserID:        rate.UserID,
+		Salary:        rate.Salary,
+		HourlyRate:    rate.HourlyRate,
+		Currency:      rate.Currency,
+		EffectiveFrom: rate.EffectiveFrom,
+		SetBy:         rate.SetBy,
+		CreatedAt:     rate.CreatedAt,
+	}
+}
+
+func CompensationRatesToResponse(rates []db.CompensationRate) []CompensationRateResponse {
+	responses := make([]CompensationRateResponse, len(rates))
+	for i, rate := range rates {
+		responses[i] = CompensationRateToResponse(&rate)
+	}
+	return responses
+}
@@ -213,10 +213,12 @@ func (h *CompanyHandler) AddCompanyMember(c *gin.Context) {
 		companyID,
 		req.UserID,
 		req.Role,
+		req.ToCompensation(),
 		req.RequestingUserID,
 	)
 	if err != nil {
-		if err.Error() == "user cannot add members to this company" {
+		if err.Error() == "user cannot add members to this company" || err.Error() == "user cannot manage compensation for this company" ||
+			err.Error() == "only owners and admins can grant or revoke admin" {
 			responses.Forbidden(c, err.Error())
 			return
 		}
@@ -224,17 +226,14 @@ func (h *CompanyHandler) AddCompanyMember(c *gin.Context) {
 			responses.Conflict(c, err.Error())
 			return
 		}
+		if err.Error() == "invalid role" || isCompensationInputError(err) {
+			responses.BadRequest(c, err.Error())
+			return
+		}
 		responses.InternalError(c, err.Error())
 		return
 	}
 
-	if req.Salary != nil {
-		member.Salary = req.Salary
-	}
-	if req.HourlyRate != nil {
-		member.HourlyRate = req.HourlyRate
-	}
-
 	response := MemberToResponse(member)
 	responses.Created(c, "Member added successfully", response)
 }
@@ -274,3 +273,233 @@ func (h *CompanyHandler) RemoveCompanyMember(c *gin.Context) {
 
 	responses.Success(c, "Member removed successfully", nil)
 }
+
+func (h *CompanyHandler) UpdateCompanyMemberRole(c *gin.Context) {
+	companyID := c.Param("id")
+	userID := c.Param("userId")
+
+	var req UpdateMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.companyService.UpdateCompanyMemberRole(companyID, userID, req.Role, req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot update members of this company" || err.Error() == "cannot change company owner role" ||
+			err.Error() == "cannot change your own role" || err.Error() == "only owners and admins can grant or revoke admin" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "user is not a member of this company" {
+			responses.NotFound(c, err.Error())
+			return
+		}
+		if err.Error() == "invalid role" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Success(c, "Member role updated successfully", response)
+}
+
+func (h *CompanyHandler) InviteCompanyMember(c *gin.Context) {
+	companyID := c.Param("id")
+
+	var req InviteMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.companyService.InviteCompanyMember(companyID, req.UserID, req.Role, req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot add members to this company" || err.Error() == "only owners and admins can grant or revoke admin" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "user is already a member of this company" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		if err.Error() == "invalid role" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Created(c, "Invitation sent successfully", response)
+}
+
+func (h *CompanyHandler) GetUserInvitations(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	invitations, err := h.companyService.GetUserInvitations(userID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	memberResponses := MembersToResponse(invitations)
+	response := MemberListResponse{
+		Data: memberResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(memberResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Invitations retrieved successfully", response)
+}
+
+func (h *CompanyHandler) AcceptInvitation(c *gin.Context) {
+	companyID := c.Param("id")
+
+	var req AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.companyService.AcceptInvitation(companyID, req.UserID)
+	if err != nil {
+		if err.Error() == "invitation not found" {
+			responses.NotFound(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Success(c, "Invitation accepted successfully", response)
+}
+
+func (h *CompanyHandler) SetMemberCompensation(c *gin.Context) {
+	companyID := c.Param("id")
+	userID := c.Param("userId")
+
+	var req SetCompensationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	rate, err := h.companyService.SetMemberCompensation(companyID, userID, req.ToCompensation(), req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot manage compensation for this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "user is not a member of this company" {
+			responses.NotFound(c, err.Error())
+			return
+		}
+		if isCompensationInputError(err) {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := CompensationRateToResponse(rate)
+	responses.Created(c, "Compensation updated successfully", response)
+}
+
+func (h *CompanyHandler) GetMemberCompensationHistory(c *gin.Context) {
+	companyID := c.Param("id")
+	userID := c.Param("userId")
+
+	requestingUserID := c.Query("userId")
+	if requestingUserID == "" {
+		requestingUserID = c.GetHeader("X-User-ID")
+	}
+
+	if requestingUserID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	rates, err := h.companyService.GetMemberCompensationHistory(companyID, userID, requestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot view compensation for this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	rateResponses := CompensationRatesToResponse(rates)
+	response := types.ListResponse[CompensationRateResponse]{
+		Data: rateResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(rateResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Compensation history retrieved successfully", response)
+}
+
+func (h *CompanyHandler) GetCompanyRoles(c *gin.Context) {
+	companyID := c.Param("id")
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	roles, err := h.companyService.GetCompanyRoles(companyID, userID)
+	if err != nil {
+		if err.Error() == "user cannot access this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	roleResponses := RolesToResponse(roles)
+	responses.Success(c, "Roles retrieved successfully", gin.H{
+		"roles": roleResponses,
+		"total": len(roleResponses),
+	})
+}
+
+func (h *CompanyHandler) GetCompanyTemplates(c *gin.Context) {
+	templates := h.companyService.GetCompanyTemplates()
+	responses.Success(c, "Company templates retrieved successfully", gin.H{
+		"templates": templates,
+		"total":     len(templates),
+	})
+}
+
+// Private helper methods
+
+func isCompensationInputError(err error) bool {
+	switch err.Error() {
+	case "salary or hourly rate is required", "compensation cannot be negative", "invalid currency code":
+		return true
+	}
+	return false
+}
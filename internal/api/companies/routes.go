@@ -25,5 +25,21 @@ func RegisterRoutes(router *gin.RouterGroup, companyService *companies.CompanySe
 		internal.GET("/:id/members", handler.GetCompanyMembers)              // Get company members
 		internal.POST("/:id/members", handler.AddCompanyMember)              // Add member to company
 		internal.DELETE("/:id/members/:userId", handler.RemoveCompanyMember) // Remove member from company
+
+		// Member roles and invitations
+		internal.PUT("/:id/members/:userId/role", handler.UpdateCompanyMemberRole) // Change a member's role
+		internal.POST("/:id/invitations", handler.InviteCompanyMember)             // Invite a user; they join on accepting
+		internal.POST("/:id/invitations/accept", handler.AcceptInvitation)         // Accept an invitation (body: userId)
+		internal.GET("/invitations", handler.GetUserInvitations)                   // Pending invitations (query: userId)
+
+		// Member compensation
+		internal.GET("/:id/members/:userId/compensation", handler.GetMemberCompensationHistory) // Rate history (query: userId)
+		internal.PUT("/:id/members/:userId/compensation", handler.SetMemberCompensation)        // Record an effective-dated rate change
+
+		// Company roles
+		internal.GET("/:id/roles", handler.GetCompanyRoles) // Get roles seeded from the company template
 	}
+
+	// Company templates keyed on company type
+	router.GET("/internal/company-templates", handler.GetCompanyTemplates)
 }
@@ -0,0 +1,79 @@
+package costs
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/costs"
+)
+
+// Response DTOs
+type CostLineResponse struct {
+	Month               string  `json:"month"` // YYYY-MM
+	UserID              string  `json:"userId"`
+	Role                string  `json:"role"`
+	ProjectID           *uint   `json:"projectId"`
+	Currency            string  `json:"currency"`
+	MonthlyCompensation float64 `json:"monthlyCompensation"`
+	Share               float64 `json:"share"`
+	Amount              float64 `json:"amount"`
+}
+
+type MonthlyTotalResponse struct {
+	Month    string  `json:"month"`
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
+
+type CostReportResponse struct {
+	CompanyID     string                 `json:"companyId"`
+	ProjectID     *uint                  `json:"projectId,omitempty"`
+	From          string                 `json:"from"`
+	To            string                 `json:"to"`
+	HoursPerMonth float64                `json:"hoursPerMonth"`
+	Lines         []CostLineResponse     `json:"lines"`
+	Monthly       []MonthlyTotalResponse `json:"monthly"`
+	Totals        map[string]float64     `json:"totals"`
+}
+
+// monthLayout is the YYYY-MM format used for report months
+const monthLayout = "2006-01"
+
+func formatMonth(month time.Time) string {
+	return month.Format(monthLayout)
+}
+
+func CostReportToResponse(report *costs.CostReport) CostReportResponse {
+	lines := make([]CostLineResponse, len(report.Lines))
+	for i, line := range report.Lines {
+		lines[i] = CostLineResponse{
+			Month:               formatMonth(line.Month),
+			UserID:              line.UserID,
+			Role:                line.Role,
+			ProjectID:           line.ProjectID,
+			Currency:            line.Currency,
+			MonthlyCompensation: line.MonthlyCompensation,
+			Share:               line.Share,
+			Amount:              line.Amount,
+		}
+	}
+
+	monthly := make([]MonthlyTotalResponse, len(report.Monthly))
+	for i, total := range report.Monthly {
+		monthly[i] = MonthlyTotalResponse{
+			Month:    formatMonth(total.Month),
+			Currency: total.Currency,
+			Amount:   total.Amount,
+		}
+	}
+
+	return CostReportResponse{
+		CompanyID:     report.CompanyID,
+		ProjectID:     report.ProjectID,
+		From:          formatMonth(report.From),
+		To:            formatMonth(report.To),
+		HoursPerMonth: report.HoursPerMonth,
+		Lines:         lines,
+		Monthly:       monthly,
+		Totals:        report.Totals,
+	}
+}
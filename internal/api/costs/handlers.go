@@ -0,0 +1,171 @@
+package costs
+
+import (
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/costs"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+type CostHandler struct {
+	costService *costs.CostService
+}
+
+func NewCostHandler(costService *costs.CostService) *CostHandler {
+	return &CostHandler{
+		costService: costService,
+	}
+}
+
+func (h *CostHandler) GetCompanyCosts(c *gin.Context) {
+	companyID := c.Param("id")
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	options, ok := parseCostOptions(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.costService.GetCompanyCostReport(companyID, userID, options)
+	if err != nil {
+		handleCostError(c, err)
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		writeCSV(c, "company-"+companyID+"-costs.csv", report)
+		return
+	}
+
+	response := CostReportToResponse(report)
+	responses.Success(c, "Company costs retrieved successfully", response)
+}
+
+func (h *CostHandler) GetProjectCosts(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	options, ok := parseCostOptions(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.costService.GetProjectCostReport(uint(id), userID, options)
+	if err != nil {
+		if err.Error() == "record not found" {
+			responses.NotFound(c, "Project not found")
+			return
+		}
+		handleCostError(c, err)
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		writeCSV(c, "project-"+idStr+"-costs.csv", report)
+		return
+	}
+
+	response := CostReportToResponse(report)
+	responses.Success(c, "Project costs retrieved successfully", response)
+}
+
+// Private helper methods
+
+// parseCostOptions reads from, to (YYYY-MM) and hoursPerMonth from the query.
+// It writes the error response itself and returns false on invalid input.
+func parseCostOptions(c *gin.Context) (costs.CostOptions, bool) {
+	var options costs.CostOptions
+
+	if from := c.Query("from"); from != "" {
+		parsed, err := time.Parse(monthLayout, from)
+		if err != nil {
+			responses.BadRequest(c, "Invalid from month, expected YYYY-MM")
+			return options, false
+		}
+		options.From = parsed
+	}
+
+	if to := c.Query("to"); to != "" {
+		parsed, err := time.Parse(monthLayout, to)
+		if err != nil {
+			responses.BadRequest(c, "Invalid to month, expected YYYY-MM")
+			return options, false
+		}
+		options.To = parsed
+	}
+
+	if hours := c.Query("hoursPerMonth"); hours != "" {
+		parsed, err := strconv.ParseFloat(hours, 64)
+		if err != nil || parsed <= 0 {
+			responses.BadRequest(c, "Invalid hoursPerMonth")
+			return options, false
+		}
+		options.HoursPerMonth = parsed
+	}
+
+	return options, true
+}
+
+func handleCostError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "user cannot view costs for this company":
+		responses.Forbidden(c, err.Error())
+	case "project does not belong to a company",
+		"report end is before its start",
+		"report range exceeds " + strconv.Itoa(costs.MaxReportMonths) + " months":
+		responses.BadRequest(c, err.Error())
+	default:
+		responses.InternalError(c, err.Error())
+	}
+}
+
+func writeCSV(c *gin.Context, filename string, report *costs.CostReport) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	writer := csv.NewWriter(c.Writer)
+	writer.Write([]string{"month", "user_id", "role", "project_id", "currency", "monthly_compensation", "share", "amount"})
+	for _, line := range report.Lines {
+		projectID := ""
+		if line.ProjectID != nil {
+			projectID = strconv.FormatUint(uint64(*line.ProjectID), 10)
+		}
+		writer.Write([]string{
+			formatMonth(line.Month),
+			line.UserID,
+			line.Role,
+			projectID,
+			line.Currency,
+			strconv.FormatFloat(line.MonthlyCompensation, 'f', 2, 64),
+			strconv.FormatFloat(line.Share, 'f', 4, 64),
+			strconv.FormatFloat(line.Amount, 'f', 2, 64),
+		})
+	}
+	writer.Flush()
+}
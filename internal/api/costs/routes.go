@@ -0,0 +1,22 @@
+package costs
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/costs"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers all cost report routes
+func RegisterRoutes(router *gin.RouterGroup, costService *costs.CostService) {
+	handler := NewCostHandler(costService)
+
+	// Internal API routes for service-to-service communication
+	companies := router.Group("/internal/companies")
+	{
+		companies.GET("/:id/costs", handler.GetCompanyCosts) // Labor cost report (query: userId, from, to, hoursPerMonth, format=csv)
+	}
+
+	projects := router.Group("/internal/projects")
+	{
+		projects.GET("/:id/costs", handler.GetProjectCosts) // Labor cost report for one project (same query params)
+	}
+}
@@ -0,0 +1,84 @@
+package customfields
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+)
+
+// Request DTOs - company fields are changed by a user, project type fields by
+// the service that registered the type
+type CreateDefinitionRequest struct {
+	CompanyID     *string  `json:"companyId"`
+	ProjectType   *string  `json:"projectType"`
+	Key           string   `json:"key" binding:"required"`
+	Label         string   `json:"label"` // Defaults to the key
+	Type          string   `json:"type" binding:"required"`
+	Required      bool     `json:"required"`
+	AllowedValues []string `json:"allowedValues"`
+	UserID        string   `json:"userId"`
+	Service       string   `json:"service"`
+}
+
+type UpdateDefinitionRequest struct {
+	Label         string   `json:"label"`         // Omit to keep the current label
+	Required      *bool    `json:"required"`      // Omit to keep the current flag
+	AllowedValues []string `json:"allowedValues"` // Omit to keep the current values
+	UserID        string   `json:"userId"`
+	Service       string   `json:"service"`
+}
+
+// Response DTOs
+type DefinitionResponse struct {
+	ID            uint      `json:"id"`
+	CompanyID     *string   `json:"companyId,omitempty"`
+	ProjectType   *string   `json:"projectType,omitempty"`
+	Key           string    `json:"key"`
+	Label         string    `json:"label"`
+	Type          string    `json:"type"`
+	Required      bool      `json:"required"`
+	AllowedValues []string  `json:"allowedValues"`
+	CreatedBy     string    `json:"createdBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// Use standardized list responses
+type DefinitionListResponse = types.ListResponse[DefinitionResponse]
+
+func (r *CreateDefinitionRequest) ToDefinition() *db.CustomFieldDefinition {
+	return &db.CustomFieldDefinition{
+		CompanyID:     r.CompanyID,
+		ProjectType:   r.ProjectType,
+		Key:           r.Key,
+		Label:         r.Label,
+		Type:          r.Type,
+		Required:      r.Required,
+		AllowedValues: r.AllowedValues,
+	}
+}
+
+func DefinitionToResponse(definition *db.CustomFieldDefinition) DefinitionResponse {
+	return DefinitionResponse{
+		ID:            definition.ID,
+		CompanyID:     definition.CompanyID,
+		ProjectType:   definition.ProjectType,
+		Key:           definition.Key,
+		Label:         definition.Label,
+		Type:          definition.Type,
+		Required:      definition.Required,
+		AllowedValues: definition.AllowedValues,
+		CreatedBy:     definition.CreatedBy,
+		CreatedAt:     definition.CreatedAt,
+		UpdatedAt:     definition.UpdatedAt,
+	}
+}
+
+func DefinitionsToResponse(definitions []db.CustomFieldDefinition) []DefinitionResponse {
+	responses := make([]DefinitionResponse, len(definitions))
+	for i, definition := range definitions {
+		responses[i] = DefinitionToResponse(&definition)
+	}
+	return responses
+}
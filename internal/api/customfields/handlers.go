@@ -0,0 +1,141 @@
+package customfields
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/customfields"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomFieldHandler struct {
+	customFieldService *customfields.CustomFieldService
+}
+
+func NewCustomFieldHandler(customFieldService *customfields.CustomFieldService) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		customFieldService: customFieldService,
+	}
+}
+
+func (h *CustomFieldHandler) CreateDefinition(c *gin.Context) {
+	var req CreateDefinitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	definition, err := h.customFieldService.CreateDefinition(req.ToDefinition(), req.UserID, req.Service)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := DefinitionToResponse(definition)
+	responses.Created(c, "Custom field created successfully", response)
+}
+
+func (h *CustomFieldHandler) GetDefinitions(c *gin.Context) {
+	var companyID, projectType *string
+	if id := c.Query("companyId"); id != "" {
+		companyID = &id
+	}
+	if name := c.Query("projectType"); name != "" {
+		projectType = &name
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if companyID != nil && userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	definitions, err := h.customFieldService.GetDefinitions(companyID, projectType, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	definitionResponses := DefinitionsToResponse(definitions)
+	response := DefinitionListResponse{
+		Data: definitionResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(definitionResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Custom fields retrieved successfully", response)
+}
+
+func (h *CustomFieldHandler) UpdateDefinition(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid custom field ID")
+		return
+	}
+
+	var req UpdateDefinitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	definition, err := h.customFieldService.UpdateDefinition(uint(id), req.Label, req.Required, req.AllowedValues, req.UserID, req.Service)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := DefinitionToResponse(definition)
+	responses.Success(c, "Custom field updated successfully", response)
+}
+
+func (h *CustomFieldHandler) DeleteDefinition(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid custom field ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	err = h.customFieldService.DeleteDefinition(uint(id), userID, c.Query("service"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	responses.Success(c, "Custom field deleted successfully", nil)
+}
+
+// Private helper methods
+
+func respondError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "user cannot manage custom fields in this company", "user cannot access this company",
+		"project type is registered by another service":
+		responses.Forbidden(c, err.Error())
+	case "custom field not found", "company not found", "project type not found":
+		responses.NotFound(c, err.Error())
+	case "custom field key already exists":
+		responses.Conflict(c, err.Error())
+	case "company ID or project type is required", "custom field needs either a company ID or a project type",
+		"invalid custom field key", "invalid custom field type",
+		"allowed values only apply to select and multiselect fields",
+		"select and multiselect fields need allowed values", "allowed values must be unique and not empty":
+		responses.BadRequest(c, err.Error())
+	default:
+		responses.InternalError(c, err.Error())
+	}
+}
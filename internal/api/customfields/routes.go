@@ -0,0 +1,20 @@
+package customfields
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/customfields"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the custom field definition routes
+func RegisterRoutes(router *gin.RouterGroup, customFieldService *customfields.CustomFieldService) {
+	handler := NewCustomFieldHandler(customFieldService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/custom-fields")
+	{
+		internal.POST("", handler.CreateDefinition)       // Define a field for a company or project type
+		internal.GET("", handler.GetDefinitions)          // List fields (query: companyId and userId, or projectType)
+		internal.PUT("/:id", handler.UpdateDefinition)    // Change label, required flag or allowed values
+		internal.DELETE("/:id", handler.DeleteDefinition) // Delete field and its values (query: userId or service)
+	}
+}
@@ -0,0 +1,35 @@
+package email
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// Request DTOs
+type UpdateSettingsRequest struct {
+	UserID string `json:"userId" binding:"required"`
+	Email  string `json:"email" binding:"required"`
+	Locale string `json:"locale"` // en, es or pt; defaults to the server default
+}
+
+// Response DTOs
+type EmailSettingsResponse struct {
+	UserID         string     `json:"userId"`
+	Email          string     `json:"email"`
+	Locale         string     `json:"locale"`
+	Subscribed     bool       `json:"subscribed"`
+	UnsubscribedAt *time.Time `json:"unsubscribedAt,omitempty"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+func SettingsToResponse(settings *db.EmailSettings) EmailSettingsResponse {
+	return EmailSettingsResponse{
+		UserID:         settings.UserID,
+		Email:          settings.Email,
+		Locale:         settings.Locale,
+		Subscribed:     settings.UnsubscribedAt == nil,
+		UnsubscribedAt: settings.UnsubscribedAt,
+		UpdatedAt:      settings.UpdatedAt,
+	}
+}
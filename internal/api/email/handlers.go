@@ -0,0 +1,97 @@
+package email
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/email"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Unsubscribe</title></head>
+<body style="font-family: sans-serif;">
+{{if .Done}}<p>You will no longer receive notification emails.</p>
+{{else}}<form method="post"><p>Stop receiving notification emails?</p><button type="submit">Unsubscribe</button></form>
+{{end}}</body>
+</html>
+`))
+
+type EmailHandler struct {
+	emailService *email.EmailService
+}
+
+func NewEmailHandler(emailService *email.EmailService) *EmailHandler {
+	return &EmailHandler{
+		emailService: emailService,
+	}
+}
+
+func (h *EmailHandler) GetSettings(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	settings, err := h.emailService.GetSettings(userID)
+	if err != nil {
+		if err.Error() == "record not found" {
+			responses.NotFound(c, "Email settings not found")
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := SettingsToResponse(settings)
+	responses.Success(c, "Email settings retrieved successfully", response)
+}
+
+func (h *EmailHandler) UpdateSettings(c *gin.Context) {
+	var req UpdateSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	settings, err := h.emailService.UpdateSettings(req.UserID, req.Email, req.Locale)
+	if err != nil {
+		if err.Error() == "invalid email address" || err.Error() == "unsupported locale" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := SettingsToResponse(settings)
+	responses.Success(c, "Email settings updated successfully", response)
+}
+
+func (h *EmailHandler) ConfirmUnsubscribe(c *gin.Context) {
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusOK)
+	unsubscribePage.Execute(c.Writer, struct{ Done bool }{false})
+}
+
+func (h *EmailHandler) Unsubscribe(c *gin.Context) {
+	if err := h.emailService.Unsubscribe(c.Param("token")); err != nil {
+		if err.Error() == "invalid unsubscribe token" {
+			responses.NotFound(c, "Unsubscribe link not found")
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusOK)
+	unsubscribePage.Execute(c.Writer, struct{ Done bool }{true})
+}
@@ -0,0 +1,25 @@
+package email
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/email"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers email settings and unsubscribe routes
+func RegisterRoutes(router *gin.RouterGroup, emailService *email.EmailService) {
+	handler := NewEmailHandler(emailService)
+
+	// Unsubscribe links are opened from emails and authenticated by their signature
+	public := router.Group("/email")
+	{
+		public.GET("/unsubscribe/:token", handler.ConfirmUnsubscribe) // Confirmation page, so link scanners don't unsubscribe
+		public.POST("/unsubscribe/:token", handler.Unsubscribe)       // Unsubscribe, also used for RFC 8058 one-click
+	}
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/email")
+	{
+		internal.GET("/settings", handler.GetSettings)    // Address and locale (query: userId)
+		internal.PUT("/settings", handler.UpdateSettings) // Set address and locale; resubscribes
+	}
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// Response DTOs
+type EventResponse struct {
+	ID         int64       `json:"id"`
+	EntityType string      `json:"entityType"`
+	Action     string      `json:"action"`
+	ProjectID  *uint       `json:"projectId,omitempty"`
+	CompanyID  *string     `json:"companyId,omitempty"`
+	ActorID    string      `json:"actorId"`
+	UserID     string      `json:"userId,omitempty"`
+	Payload    interface{} `json:"payload"`
+	CreatedAt  time.Time   `json:"createdAt"`
+}
+
+// ResetResponse tells a resuming client that it missed events and must reload
+type ResetResponse struct {
+	Reason string `json:"reason"`
+}
+
+// Conversion functions
+func EventToResponse(event *db.ChangeEvent) EventResponse {
+	return EventResponse{
+		ID:         event.ID,
+		EntityType: event.EntityType,
+		Action:     event.Action,
+		ProjectID:  event.ProjectID,
+		CompanyID:  event.CompanyID,
+		ActorID:    event.ActorID,
+		UserID:     event.UserID,
+		Payload:    event.Payload,
+		CreatedAt:  event.CreatedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package events
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// reconnectDelay is sent as the SSE retry field, in milliseconds
+const reconnectDelay = 3000
+
+// writeTimeout replaces the server's write timeout, which would otherwise
+// end every stream after a few seconds; it is extended before each write.
+const writeTimeout = 2 * events.HeartbeatInterval
+
+type EventHandler struct {
+	eventService *events.EventService
+	access       events.AccessChecker
+}
+
+func NewEventHandler(eventService *events.EventService, access events.AccessChecker) *EventHandler {
+	return &EventHandler{
+		eventService: eventService,
+		access:       access,
+	}
+}
+
+func (h *EventHandler) Stream(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	// Browsers resume with the header; the query parameter is for clients that cannot set it
+	var lastID *int64
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("lastEventId")
+	}
+	if lastEventID != "" {
+		value, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || value < 0 {
+			responses.BadRequest(c, "Invalid Last-Event-ID")
+			return
+		}
+		lastID = &value
+	}
+
+	var entityTypes []string
+	if value := c.Query("types"); value != "" {
+		entityTypes = strings.Split(value, ",")
+		for _, entityType := range entityTypes {
+			if !slices.Contains(events.EntityTypes, entityType) {
+				responses.BadRequest(c, "Invalid event type")
+				return
+			}
+		}
+	}
+
+	cursor, expired, err := h.eventService.Cursor(lastID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Keep proxies from buffering the stream
+	c.Status(http.StatusOK)
+
+	controller := http.NewResponseController(c.Writer)
+	write := func(write func() error) error {
+		if err := controller.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			return err
+		}
+		if err := write(); err != nil {
+			return err
+		}
+		return controller.Flush()
+	}
+
+	err = write(func() error {
+		if expired {
+			return sse.Encode(c.Writer, sse.Event{
+				Id:    strconv.FormatInt(cursor, 10),
+				Event: "reset",
+				Retry: reconnectDelay,
+				Data:  ResetResponse{Reason: "events since Last-Event-ID are no longer available"},
+			})
+		}
+		_, err := io.WriteString(c.Writer, "retry:"+strconv.Itoa(reconnectDelay)+"\n\n")
+		return err
+	})
+	if err != nil {
+		return
+	}
+
+	filter := events.NewFilter(userID, h.access, entityTypes)
+	h.eventService.Stream(c.Request.Context(), cursor, filter,
+		func(event *db.ChangeEvent) error {
+			return write(func() error {
+				return sse.Encode(c.Writer, sse.Event{
+					Id:    strconv.FormatInt(event.ID, 10),
+					Event: event.EntityType + "." + event.Action,
+					Data:  EventToResponse(event),
+				})
+			})
+		},
+		func() error {
+			return write(func() error {
+				_, err := io.WriteString(c.Writer, ": heartbeat\n\n")
+				return err
+			})
+		},
+	)
+}
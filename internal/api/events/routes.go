@@ -0,0 +1,17 @@
+package events
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the change event stream
+func RegisterRoutes(router *gin.RouterGroup, eventService *events.EventService, access events.AccessChecker) {
+	handler := NewEventHandler(eventService, access)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/events")
+	{
+		internal.GET("/stream", handler.Stream) // Server-sent events of accessible project and company changes (query: userId, types, lastEventId; header: Last-Event-ID)
+	}
+}
@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graph-gophers/graphql-go/types"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Query limits
+const (
+	DefaultMaxComplexity = 5000
+	DefaultMaxDepth      = 10
+	// listSize is the number of items a list field is assumed to return
+	listSize = 10
+)
+
+// complexity estimates the cost of the operation before it runs. Every field
+// costs 1 and the fields selected under a list count listSize times, so
+// nesting lists multiplies the cost. Introspection fields cost 1. Queries
+// that do not parse cost 0 and are rejected by the executor instead.
+func complexity(schema *types.Schema, query, operationName string) int {
+	document, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return 0
+	}
+
+	var operation *ast.OperationDefinition
+	if operationName != "" {
+		operation = document.Operations.ForName(operationName)
+	} else if len(document.Operations) == 1 {
+		operation = document.Operations[0]
+	}
+	if operation == nil {
+		return 0
+	}
+
+	root, ok := schema.EntryPoints[string(operation.Operation)]
+	if !ok {
+		return 0
+	}
+	return selectionCost(schema, document, operation.SelectionSet, root.TypeName(), make(map[string]bool))
+}
+
+func selectionCost(schema *types.Schema, document *ast.QueryDocument, selections ast.SelectionSet, typeName string, fragments map[string]bool) int {
+	cost := 0
+	for _, selection := range selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			cost += fieldCost(schema, document, selection, typeName, fragments)
+		case *ast.InlineFragment:
+			fragmentType := typeName
+			if selection.TypeCondition != "" {
+				fragmentType = selection.TypeCondition
+			}
+			cost += selectionCost(schema, document, selection.SelectionSet, fragmentType, fragments)
+		case *ast.FragmentSpread:
+			// Cyclic fragments are invalid; counting them once keeps this finite
+			fragment := document.Fragments.ForName(selection.Name)
+			if fragment == nil || fragments[selection.Name] {
+				continue
+			}
+			fragments[selection.Name] = true
+			cost += selectionCost(schema, document, fragment.SelectionSet, fragment.TypeCondition, fragments)
+			delete(fragments, selection.Name)
+		}
+	}
+	return cost
+}
+
+func fieldCost(schema *types.Schema, document *ast.QueryDocument, field *ast.Field, typeName string, fragments map[string]bool) int {
+	if strings.HasPrefix(field.Name, "__") {
+		return 1
+	}
+	object, ok := schema.Types[typeName].(*types.ObjectTypeDefinition)
+	if !ok {
+		return 1
+	}
+	definition := object.Fields.Get(field.Name)
+	if definition == nil {
+		return 1
+	}
+
+	multiplier := 1
+	fieldType := definition.Type
+	for {
+		if nonNull, ok := fieldType.(*types.NonNull); ok {
+			fieldType = nonNull.OfType
+			continue
+		}
+		if list, ok := fieldType.(*types.List); ok {
+			multiplier *= listSize
+			fieldType = list.OfType
+			continue
+		}
+		break
+	}
+
+	named, ok := fieldType.(types.NamedType)
+	if !ok || len(field.SelectionSet) == 0 {
+		return 1
+	}
+	return 1 + multiplier*selectionCost(schema, document, field.SelectionSet, named.TypeName(), fragments)
+}
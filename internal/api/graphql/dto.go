@@ -0,0 +1,23 @@
+package graphql
+
+// QueryRequest is the standard GraphQL-over-HTTP request body
+type QueryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ErrorResponse reports a query rejected before it ran, in the GraphQL response format
+type ErrorResponse struct {
+	Errors []ErrorMessage `json:"errors"`
+}
+
+type ErrorMessage struct {
+	Message string `json:"message"`
+}
+
+// Limits bound the queries the endpoint accepts
+type Limits struct {
+	MaxComplexity int
+	MaxDepth      int
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/http"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/graph"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+type GraphQLHandler struct {
+	schema         *graphqlgo.Schema
+	graphService   *graph.GraphService
+	projectService *projects.ProjectService
+	maxComplexity  int
+}
+
+func NewGraphQLHandler(graphService *graph.GraphService, projectService *projects.ProjectService, limits Limits) *GraphQLHandler {
+	return &GraphQLHandler{
+		schema:         graphqlgo.MustParseSchema(schemaString, &queryResolver{}, graphqlgo.MaxDepth(limits.MaxDepth)),
+		graphService:   graphService,
+		projectService: projectService,
+		maxComplexity:  limits.MaxComplexity,
+	}
+}
+
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	var req QueryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	if req.Query == "" {
+		responses.BadRequest(c, "Query required")
+		return
+	}
+
+	if cost := complexity(h.schema.ASTSchema(), req.Query, req.OperationName); cost > h.maxComplexity {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Errors: []ErrorMessage{{
+			Message: fmt.Sprintf("query complexity %d exceeds the limit of %d", cost, h.maxComplexity),
+		}}})
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), requestContextKey{}, newRequest(userID, h.graphService, h.projectService))
+	c.JSON(http.StatusOK, h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
@@ -0,0 +1,72 @@
+package graphql
+
+import "sync"
+
+// loader batches lookups by key. Keys are primed when a parent list is
+// resolved, so the first lookup fetches every key its siblings will ask
+// for in one call, and later lookups are served from the results.
+type loader[K comparable, V any] struct {
+	fetch func(keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending map[K]bool
+	results map[K]*loaderResult[V]
+}
+
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	found bool
+	err   error
+}
+
+func newLoader[K comparable, V any](fetch func(keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		pending: make(map[K]bool),
+		results: make(map[K]*loaderResult[V]),
+	}
+}
+
+// prime queues keys for the next fetch
+func (l *loader[K, V]) prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if l.results[key] == nil {
+			l.pending[key] = true
+		}
+	}
+}
+
+// load returns the value for key, fetching it with every pending key if it
+// was not fetched yet
+func (l *loader[K, V]) load(key K) (V, bool, error) {
+	l.mu.Lock()
+	if result := l.results[key]; result != nil {
+		l.mu.Unlock()
+		<-result.done
+		return result.value, result.found, result.err
+	}
+
+	l.pending[key] = true
+	keys := make([]K, 0, len(l.pending))
+	batch := make(map[K]*loaderResult[V], len(l.pending))
+	for pendingKey := range l.pending {
+		keys = append(keys, pendingKey)
+		batch[pendingKey] = &loaderResult[V]{done: make(chan struct{})}
+		l.results[pendingKey] = batch[pendingKey]
+	}
+	l.pending = make(map[K]bool)
+	l.mu.Unlock()
+
+	values, err := l.fetch(keys)
+	for batchKey, result := range batch {
+		result.value, result.found = values[batchKey]
+		result.err = err
+		close(result.done)
+	}
+
+	result := batch[key]
+	return result.value, result.found, result.err
+}
@@ -0,0 +1,185 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/graph"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+)
+
+type requestContextKey struct{}
+
+// request holds the user and the loaders of one query. Loaders and access
+// decisions are never shared between queries, so every query sees current
+// data and permissions.
+type request struct {
+	userID         string
+	graphService   *graph.GraphService
+	projectService *projects.ProjectService
+
+	projects        *loader[uint, db.BaseProject]
+	children        *loader[uint, []db.BaseProject]
+	companyProjects *loader[string, []db.BaseProject]
+	members         *loader[uint, []db.ProjectMember]
+	companies       *loader[string, db.Company]
+	companyMembers  *loader[string, []db.CompanyMember]
+	userMemberships *loader[string, []db.CompanyMember]
+
+	mu            sync.Mutex
+	projectAccess map[uint]bool
+	companyOnce   sync.Once
+	companyAccess map[string]bool
+	companyErr    error
+}
+
+func newRequest(userID string, graphService *graph.GraphService, projectService *projects.ProjectService) *request {
+	return &request{
+		userID:          userID,
+		graphService:    graphService,
+		projectService:  projectService,
+		projects:        newLoader(graphService.ProjectsByID),
+		children:        newLoader(graphService.ProjectsByParent),
+		companyProjects: newLoader(graphService.ProjectsByCompany),
+		members:         newLoader(graphService.MembersByProject),
+		companies:       newLoader(graphService.CompaniesByID),
+		companyMembers:  newLoader(graphService.CompanyMembersByCompany),
+		userMemberships: newLoader(graphService.CompanyMembersByUser),
+		projectAccess:   make(map[uint]bool),
+	}
+}
+
+func requestFromContext(ctx context.Context) *request {
+	r, _ := ctx.Value(requestContextKey{}).(*request)
+	return r
+}
+
+// canAccessProject applies the project access rules once per project
+func (r *request) canAccessProject(projectID uint) (bool, error) {
+	r.mu.Lock()
+	canAccess, checked := r.projectAccess[projectID]
+	r.mu.Unlock()
+	if checked {
+		return canAccess, nil
+	}
+
+	canAccess, err := r.projectService.CanAccessProject(r.userID, projectID)
+	if err != nil {
+		return false, err
+	}
+	r.grantProjects(canAccess, projectID)
+	return canAccess, nil
+}
+
+// grantProjects records access decisions that follow from another one, such
+// as sub-projects of an accessible project being accessible
+func (r *request) grantProjects(canAccess bool, projectIDs ...uint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, projectID := range projectIDs {
+		r.projectAccess[projectID] = canAccess
+	}
+}
+
+// canAccessCompany loads the user's active companies on first use
+func (r *request) canAccessCompany(companyID string) (bool, error) {
+	r.companyOnce.Do(func() {
+		r.companyAccess, r.companyErr = r.graphService.ActiveCompanyIDs(r.userID)
+	})
+	return r.companyAccess[companyID], r.companyErr
+}
+
+// project loads an accessible project
+func (r *request) project(projectID uint) (*projectResolver, error) {
+	project, found, err := r.projects.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("project not found")
+	}
+
+	canAccess, err := r.canAccessProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return nil, errors.New("user cannot access this project")
+	}
+	return &projectResolver{r: r, project: project}, nil
+}
+
+// company loads an accessible company
+func (r *request) company(companyID string) (*companyResolver, error) {
+	company, found, err := r.companies.load(companyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("company not found")
+	}
+
+	canAccess, err := r.canAccessCompany(companyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return nil, errors.New("user cannot access this company")
+	}
+	return &companyResolver{r: r, company: company}, nil
+}
+
+// The list helpers prime the loaders with the keys the items' relationships
+// use, so resolving a relationship across the whole list takes one query
+
+// projectList wraps projects the user is known to have access to
+func (r *request) projectList(projects []db.BaseProject) []*projectResolver {
+	resolvers := make([]*projectResolver, len(projects))
+	ids := make([]uint, len(projects))
+	for i, project := range projects {
+		resolvers[i] = &projectResolver{r: r, project: project}
+		ids[i] = project.ID
+		if project.CompanyID != nil {
+			r.companies.prime(*project.CompanyID)
+			r.companyMembers.prime(*project.CompanyID)
+		}
+		if project.ParentID != nil {
+			r.projects.prime(*project.ParentID)
+		}
+	}
+	r.grantProjects(true, ids...)
+	r.children.prime(ids...)
+	r.members.prime(ids...)
+	return resolvers
+}
+
+func (r *request) projectMemberList(project *projectResolver, members []db.ProjectMember) []*projectMemberResolver {
+	resolvers := make([]*projectMemberResolver, len(members))
+	for i, member := range members {
+		resolvers[i] = &projectMemberResolver{r: r, project: project, member: member}
+		r.userMemberships.prime(member.UserID)
+	}
+	return resolvers
+}
+
+func (r *request) companyList(companies []db.Company) []*companyResolver {
+	resolvers := make([]*companyResolver, len(companies))
+	for i, company := range companies {
+		resolvers[i] = &companyResolver{r: r, company: company}
+		r.companyMembers.prime(company.ID)
+		r.companyProjects.prime(company.ID)
+	}
+	return resolvers
+}
+
+// companyMemberList wraps members of companies the user can access
+func (r *request) companyMemberList(members []db.CompanyMember) []*companyMemberResolver {
+	resolvers := make([]*companyMemberResolver, len(members))
+	for i, member := range members {
+		resolvers[i] = &companyMemberResolver{r: r, member: member}
+		r.companies.prime(member.CompanyID)
+	}
+	return resolvers
+}
@@ -0,0 +1,248 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// queryResolver is the root of every query
+type queryResolver struct{}
+
+func (q *queryResolver) Project(ctx context.Context, args struct{ ID graphqlgo.ID }) (*projectResolver, error) {
+	projectID, err := strconv.ParseUint(string(args.ID), 10, 32)
+	if err != nil {
+		return nil, errors.New("invalid project ID")
+	}
+	return requestFromContext(ctx).project(uint(projectID))
+}
+
+func (q *queryResolver) Projects(ctx context.Context) ([]*projectResolver, error) {
+	r := requestFromContext(ctx)
+	userProjects, err := r.projectService.GetUserProjects(r.userID, projects.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return r.projectList(userProjects), nil
+}
+
+func (q *queryResolver) Company(ctx context.Context, args struct{ ID graphqlgo.ID }) (*companyResolver, error) {
+	return requestFromContext(ctx).company(string(args.ID))
+}
+
+func (q *queryResolver) Companies(ctx context.Context) ([]*companyResolver, error) {
+	r := requestFromContext(ctx)
+	if _, err := r.canAccessCompany(""); err != nil {
+		return nil, err
+	}
+
+	companyIDs := make([]string, 0, len(r.companyAccess))
+	for companyID := range r.companyAccess {
+		companyIDs = append(companyIDs, companyID)
+	}
+	sort.Strings(companyIDs)
+	r.companies.prime(companyIDs...)
+
+	companies := make([]db.Company, 0, len(companyIDs))
+	for _, companyID := range companyIDs {
+		company, found, err := r.companies.load(companyID)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			companies = append(companies, company)
+		}
+	}
+	return r.companyList(companies), nil
+}
+
+// Projects
+
+type projectResolver struct {
+	r       *request
+	project db.BaseProject
+}
+
+func (p *projectResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatUint(uint64(p.project.ID), 10))
+}
+
+func (p *projectResolver) Title() string                { return p.project.Title }
+func (p *projectResolver) Description() *string         { return p.project.Description }
+func (p *projectResolver) Status() string               { return p.project.Status }
+func (p *projectResolver) OwnerID() string              { return p.project.OwnerID }
+func (p *projectResolver) StartDate() *graphqlgo.Time   { return optionalTime(p.project.StartDate) }
+func (p *projectResolver) EndDate() *graphqlgo.Time     { return optionalTime(p.project.EndDate) }
+func (p *projectResolver) CompletedAt() *graphqlgo.Time { return optionalTime(p.project.CompletedAt) }
+func (p *projectResolver) CreatedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: p.project.CreatedAt}
+}
+func (p *projectResolver) UpdatedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: p.project.UpdatedAt}
+}
+
+func (p *projectResolver) Company() (*companyResolver, error) {
+	if p.project.CompanyID == nil {
+		return nil, nil
+	}
+	return p.r.company(*p.project.CompanyID)
+}
+
+// Parent checks access, since access to a sub-project does not grant access to its parent
+func (p *projectResolver) Parent() (*projectResolver, error) {
+	if p.project.ParentID == nil {
+		return nil, nil
+	}
+	return p.r.project(*p.project.ParentID)
+}
+
+// Children are accessible through the parent
+func (p *projectResolver) Children() ([]*projectResolver, error) {
+	children, _, err := p.r.children.load(p.project.ID)
+	if err != nil {
+		return nil, err
+	}
+	return p.r.projectList(children), nil
+}
+
+func (p *projectResolver) Members() ([]*projectMemberResolver, error) {
+	members, _, err := p.r.members.load(p.project.ID)
+	if err != nil {
+		return nil, err
+	}
+	return p.r.projectMemberList(p, members), nil
+}
+
+// Project members
+
+type projectMemberResolver struct {
+	r       *request
+	project *projectResolver
+	member  db.ProjectMember
+}
+
+func (m *projectMemberResolver) UserID() string { return m.member.UserID }
+func (m *projectMemberResolver) Role() string   { return m.member.Role }
+func (m *projectMemberResolver) JoinedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: m.member.JoinedAt}
+}
+func (m *projectMemberResolver) Project() *projectResolver { return m.project }
+
+func (m *projectMemberResolver) Permissions() []string {
+	if m.member.Permissions == nil {
+		return []string{}
+	}
+	return m.member.Permissions
+}
+
+func (m *projectMemberResolver) CompanyMember() (*companyMemberResolver, error) {
+	companyID := m.project.project.CompanyID
+	if companyID == nil {
+		return nil, nil
+	}
+
+	canAccess, err := m.r.canAccessCompany(*companyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return nil, errors.New("user cannot access this company")
+	}
+
+	members, _, err := m.r.companyMembers.load(*companyID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if member.UserID == m.member.UserID {
+			return m.r.companyMemberList([]db.CompanyMember{member})[0], nil
+		}
+	}
+	return nil, nil
+}
+
+// Companies leaves out memberships in companies the user cannot access
+func (m *projectMemberResolver) Companies() ([]*companyMemberResolver, error) {
+	memberships, _, err := m.r.userMemberships.load(m.member.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]db.CompanyMember, 0, len(memberships))
+	for _, membership := range memberships {
+		canAccess, err := m.r.canAccessCompany(membership.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		if canAccess {
+			visible = append(visible, membership)
+		}
+	}
+	return m.r.companyMemberList(visible), nil
+}
+
+// Companies
+
+type companyResolver struct {
+	r       *request
+	company db.Company
+}
+
+func (c *companyResolver) ID() graphqlgo.ID { return graphqlgo.ID(c.company.ID) }
+func (c *companyResolver) Name() string     { return c.company.Name }
+func (c *companyResolver) Type() string     { return c.company.Type }
+func (c *companyResolver) OwnerID() string  { return c.company.OwnerID }
+
+func (c *companyResolver) Members() ([]*companyMemberResolver, error) {
+	members, _, err := c.r.companyMembers.load(c.company.ID)
+	if err != nil {
+		return nil, err
+	}
+	return c.r.companyMemberList(members), nil
+}
+
+// Projects are accessible to every active member of the company
+func (c *companyResolver) Projects() ([]*projectResolver, error) {
+	projects, _, err := c.r.companyProjects.load(c.company.ID)
+	if err != nil {
+		return nil, err
+	}
+	return c.r.projectList(projects), nil
+}
+
+// Company members
+
+type companyMemberResolver struct {
+	r      *request
+	member db.CompanyMember
+}
+
+func (m *companyMemberResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatUint(uint64(m.member.ID), 10))
+}
+
+func (m *companyMemberResolver) UserID() string            { return m.member.UserID }
+func (m *companyMemberResolver) Role() string              { return m.member.Role }
+func (m *companyMemberResolver) Status() string            { return m.member.Status }
+func (m *companyMemberResolver) JoinedAt() *graphqlgo.Time { return optionalTime(m.member.JoinedAt) }
+func (m *companyMemberResolver) InvitedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: m.member.InvitedAt}
+}
+func (m *companyMemberResolver) InvitedBy() string { return m.member.InvitedBy }
+
+func (m *companyMemberResolver) Company() (*companyResolver, error) {
+	return m.r.company(m.member.CompanyID)
+}
+
+func optionalTime(t *time.Time) *graphqlgo.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphqlgo.Time{Time: *t}
+}
@@ -0,0 +1,22 @@
+package graphql
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/graph"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/microservice-commons/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the GraphQL endpoint
+func RegisterRoutes(router *gin.RouterGroup, graphService *graph.GraphService, projectService *projects.ProjectService, limits Limits) {
+	handler := NewGraphQLHandler(graphService, projectService, limits)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/graphql")
+	internal.Use(
+		middleware.DefaultLoggingMiddleware(),
+	)
+	{
+		internal.POST("", handler.Query) // Run a GraphQL query over projects, companies and members (query: userId; header: X-User-ID)
+	}
+}
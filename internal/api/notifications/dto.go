@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+)
+
+// Request DTOs
+type UserRequest struct {
+	UserID string `json:"userId" binding:"required"`
+}
+
+type UpdatePreferencesRequest struct {
+	UserID      string          `json:"userId" binding:"required"`
+	Preferences map[string]bool `json:"preferences" binding:"required"` // Notification type to enabled
+}
+
+// Response DTOs
+type NotificationResponse struct {
+	ID        uint              `json:"id"`
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Body      string            `json:"body"`
+	Data      map[string]string `json:"data,omitempty"`
+	ProjectID *uint             `json:"projectId,omitempty"`
+	CompanyID *string           `json:"companyId,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+	ReadAt    *time.Time        `json:"readAt"`
+}
+
+type NotificationListResponse struct {
+	types.ListResponse[NotificationResponse]
+	UnreadCount int64 `json:"unreadCount"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unreadCount"`
+}
+
+type MarkAllReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+type PreferencesResponse struct {
+	UserID      string          `json:"userId"`
+	Preferences map[string]bool `json:"preferences"`
+}
+
+func NotificationToResponse(notification *db.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Data:      notification.Data,
+		ProjectID: notification.ProjectID,
+		CompanyID: notification.CompanyID,
+		CreatedAt: notification.CreatedAt,
+		ReadAt:    notification.ReadAt,
+	}
+}
+
+func NotificationsToResponse(notifications []db.Notification) []NotificationResponse {
+	responses := make([]NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		responses[i] = NotificationToResponse(&notification)
+	}
+	return responses
+}
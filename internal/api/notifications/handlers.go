@@ -0,0 +1,182 @@
+package notifications
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService *notifications.NotificationService
+}
+
+func NewNotificationHandler(notificationService *notifications.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	options := notifications.ListOptions{UnreadOnly: c.Query("unread") == "true"}
+	if limit := c.Query("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			responses.BadRequest(c, "Invalid limit")
+			return
+		}
+		options.Limit = value
+	}
+	if offset := c.Query("offset"); offset != "" {
+		value, err := strconv.Atoi(offset)
+		if err != nil {
+			responses.BadRequest(c, "Invalid offset")
+			return
+		}
+		options.Offset = value
+	}
+
+	notificationList, err := h.notificationService.GetNotifications(userID, options)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+	unreadCount, err := h.notificationService.CountUnread(userID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	notificationResponses := NotificationsToResponse(notificationList)
+	response := NotificationListResponse{
+		ListResponse: types.ListResponse[NotificationResponse]{
+			Data: notificationResponses,
+			Meta: types.ResponseMetadata{
+				Count:     len(notificationResponses),
+				Timestamp: time.Now(),
+			},
+		},
+		UnreadCount: unreadCount,
+	}
+	responses.Success(c, "Notifications retrieved successfully", response)
+}
+
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	unreadCount, err := h.notificationService.CountUnread(userID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Unread count retrieved successfully", UnreadCountResponse{UnreadCount: unreadCount})
+}
+
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid notification ID")
+		return
+	}
+
+	var req UserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	notification, err := h.notificationService.MarkRead(uint(id), req.UserID)
+	if err != nil {
+		if err.Error() == "user cannot access this notification" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "record not found" {
+			responses.NotFound(c, "Notification not found")
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := NotificationToResponse(notification)
+	responses.Success(c, "Notification marked as read", response)
+}
+
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	var req UserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	updated, err := h.notificationService.MarkAllRead(req.UserID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Notifications marked as read", MarkAllReadResponse{Updated: updated})
+}
+
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	preferences, err := h.notificationService.GetPreferences(userID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Notification preferences retrieved successfully", PreferencesResponse{UserID: userID, Preferences: preferences})
+}
+
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	var req UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	preferences, err := h.notificationService.UpdatePreferences(req.UserID, req.Preferences)
+	if err != nil {
+		if err.Error() == "invalid notification type" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Notification preferences updated successfully", PreferencesResponse{UserID: req.UserID, Preferences: preferences})
+}
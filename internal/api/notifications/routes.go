@@ -0,0 +1,25 @@
+package notifications
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers notification inbox and preference routes
+func RegisterRoutes(router *gin.RouterGroup, notificationService *notifications.NotificationService) {
+	handler := NewNotificationHandler(notificationService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/notifications")
+	{
+		// Inbox
+		internal.GET("", handler.GetNotifications)            // List notifications, newest first (query: userId, unread, limit, offset)
+		internal.GET("/unread-count", handler.GetUnreadCount) // Unread notification count (query: userId)
+		internal.POST("/:id/read", handler.MarkRead)          // Mark one notification as read (body: userId)
+		internal.POST("/read-all", handler.MarkAllRead)       // Mark every notification as read (body: userId)
+
+		// Preferences
+		internal.GET("/preferences", handler.GetPreferences)    // Enabled notification types (query: userId)
+		internal.PUT("/preferences", handler.UpdatePreferences) // Turn notification types on or off
+	}
+}
@@ -0,0 +1,198 @@
+// Package openapi describes the projects and companies API as an OpenAPI 3
+// document built from the handler DTOs, and validates traffic against it.
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// DocumentPath is where the document is served under the API group
+const DocumentPath = "/openapi.json"
+
+var pathParam = regexp.MustCompile(`:([A-Za-z]+)`)
+
+// NewDocument builds and validates the OpenAPI document for the projects and
+// companies routes
+func NewDocument() (*openapi3.T, error) {
+	schemas := newSchemaBuilder()
+	errorResponse := schemas.response(responses.ErrorResponse{})
+
+	doc := &openapi3.T{
+		OpenAPI: "3.0.3",
+		Info: &openapi3.Info{
+			Title:       "Project Core internal API",
+			Description: "Service-to-service API for projects, companies and their members.",
+			Version:     "1.0.0",
+		},
+		Paths: openapi3.NewPaths(),
+		Tags: openapi3.Tags{
+			{Name: projectsTag, Description: "Projects, sub-projects, members and team grants"},
+			{Name: companiesTag, Description: "Companies, members, invitations and compensation"},
+		},
+	}
+
+	for _, op := range operations {
+		path := toOpenAPIPath(op.path)
+		item := doc.Paths.Value(path)
+		if item == nil {
+			item = &openapi3.PathItem{}
+			doc.Paths.Set(path, item)
+		}
+		item.SetOperation(op.method, newOperation(op, schemas, errorResponse))
+	}
+
+	doc.Components = &openapi3.Components{Schemas: schemas.components}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %w", err)
+	}
+	return doc, nil
+}
+
+// CheckRoutes reports drift between the document and the routes registered by
+// the projects and companies packages
+func CheckRoutes(routes gin.RoutesInfo) error {
+	documented := make(map[string]bool, len(operations))
+	for _, op := range operations {
+		documented[op.method+" "+op.path] = true
+	}
+
+	var errs []error
+	for _, route := range routes {
+		if !isDocumentedHandler(route.Handler) {
+			continue
+		}
+		key := route.Method + " " + route.Path
+		if !documented[key] {
+			errs = append(errs, fmt.Errorf("route %s is not in the OpenAPI document", key))
+		}
+		delete(documented, key)
+	}
+	for _, op := range operations {
+		key := op.method + " " + op.path
+		if documented[key] {
+			errs = append(errs, fmt.Errorf("OpenAPI operation %s has no route", key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Private helper methods
+
+func newOperation(op operation, schemas *schemaBuilder, errorResponse *openapi3.SchemaRef) *openapi3.Operation {
+	spec := openapi3.NewOperation()
+	spec.Summary = op.summary
+	spec.Tags = []string{op.tag}
+	spec.OperationID = operationID(op)
+
+	for _, name := range pathParam.FindAllStringSubmatch(op.path, -1) {
+		schema := openapi3.NewStringSchema()
+		if slices.Contains(op.intParams, name[1]) {
+			schema = openapi3.NewIntegerSchema().WithMin(0)
+		}
+		spec.AddParameter(openapi3.NewPathParameter(name[1]).WithSchema(schema))
+	}
+	for _, p := range op.params {
+		schema := openapi3.NewStringSchema()
+		if len(p.enum) > 0 {
+			schema = schema.WithEnum(toAny(p.enum)...)
+		}
+		if p.repeated {
+			schema = openapi3.NewArraySchema().WithItems(schema)
+		}
+		spec.AddParameter(&openapi3.Parameter{
+			Name:        p.name,
+			In:          p.in,
+			Description: p.description,
+			Schema:      openapi3.NewSchemaRef("", schema),
+		})
+	}
+
+	switch {
+	case op.raw && op.method == http.MethodPost:
+		spec.RequestBody = &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().
+			WithRequired(true).
+			WithContent(fileContent())}
+	case op.body != nil:
+		spec.RequestBody = &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().
+			WithRequired(!op.bodyOptional).
+			WithJSONSchemaRef(schemas.request(op.body))}
+	}
+
+	description := http.StatusText(op.status)
+	if op.raw && op.method == http.MethodGet {
+		spec.AddResponse(op.status, openapi3.NewResponse().WithDescription(description).WithContent(fileContent()))
+	} else {
+		envelope := envelopeSchema(op, schemas)
+		spec.AddResponse(op.status, openapi3.NewResponse().WithDescription(description).WithJSONSchemaRef(envelope))
+		if op.partial {
+			spec.AddResponse(http.StatusMultiStatus, openapi3.NewResponse().
+				WithDescription("Completed with per-item failures").
+				WithJSONSchemaRef(envelope))
+		}
+	}
+	spec.Responses.Set("default", &openapi3.ResponseRef{Value: openapi3.NewResponse().
+		WithDescription("Error").
+		WithJSONSchemaRef(errorResponse)})
+	return spec
+}
+
+// envelopeSchema mirrors responses.SuccessResponse with data typed per route
+func envelopeSchema(op operation, schemas *schemaBuilder) *openapi3.SchemaRef {
+	envelope := openapi3.NewObjectSchema().
+		WithProperty("message", openapi3.NewStringSchema()).
+		WithProperty("timestamp", openapi3.NewDateTimeSchema())
+	envelope.Required = []string{"message", "timestamp"}
+	if op.data != nil {
+		envelope.WithPropertyRef("data", schemas.response(op.data))
+		envelope.Required = append(envelope.Required, "data")
+	}
+	return openapi3.NewSchemaRef("", envelope)
+}
+
+// fileContent accepts the CSV and JSON formats of import and export
+func fileContent() openapi3.Content {
+	return openapi3.Content{
+		"application/json": openapi3.NewMediaType().WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewObjectSchema())),
+		"text/csv":         openapi3.NewMediaType().WithSchema(openapi3.NewStringSchema()),
+	}
+}
+
+// operationID turns "POST /api/internal/projects/:id/members" into
+// "postProjectsIdMembers"
+func operationID(op operation) string {
+	id := strings.ToLower(op.method)
+	for _, segment := range strings.Split(strings.TrimPrefix(op.path, "/api/internal/"), "/") {
+		for _, word := range strings.Split(strings.TrimPrefix(segment, ":"), "-") {
+			if word != "" {
+				id += strings.ToUpper(word[:1]) + word[1:]
+			}
+		}
+	}
+	return id
+}
+
+func toOpenAPIPath(path string) string {
+	return pathParam.ReplaceAllString(path, "{$1}")
+}
+
+func isDocumentedHandler(handler string) bool {
+	return strings.Contains(handler, "/internal/api/projects.") || strings.Contains(handler, "/internal/api/companies.")
+}
+
+func toAny(values []string) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
@@ -0,0 +1,175 @@
+package openapi
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+)
+
+var log = slog.Default().With(slog.String("layer", "api"), slog.String("api", "openapi"))
+
+// Validation modes for ValidationMiddleware
+const (
+	ValidationOff    = "off"    // no validation
+	ValidationLog    = "log"    // log mismatches and serve the request as usual
+	ValidationStrict = "strict" // reject bad requests with 400 and bad responses with 500
+)
+
+// ParseValidationMode checks an OPENAPI_VALIDATION value
+func ParseValidationMode(mode string) (string, error) {
+	switch mode {
+	case ValidationOff, ValidationLog, ValidationStrict:
+		return mode, nil
+	}
+	return "", errors.New("invalid OpenAPI validation mode, expected off, log or strict")
+}
+
+// ValidationMiddleware validates requests and responses of documented routes
+// against doc in log or strict mode. Strict mode is meant for tests and
+// staging, where a handler drifting from the contract should fail loudly.
+// Responses of documented routes are buffered until validated, so streaming
+// exports arrive in one piece while validation is on.
+func ValidationMiddleware(doc *openapi3.T, mode string) (gin.HandlerFunc, error) {
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, err
+	}
+	options := &openapi3filter.Options{
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		IncludeResponseStatus: true,
+	}
+	options.WithCustomSchemaErrorFunc(schemaErrorMessage)
+
+	return func(c *gin.Context) {
+		route, pathParams, err := router.FindRoute(c.Request)
+		if err != nil {
+			// Undocumented routes belong to other API packages
+			if !errors.Is(err, routers.ErrPathNotFound) && !errors.Is(err, routers.ErrMethodNotAllowed) {
+				log.Warn("OpenAPI route lookup failed", slog.String("path", c.Request.URL.Path), slog.Any("error", err))
+			}
+			c.Next()
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			log.Warn("Request does not match OpenAPI document",
+				slog.String("method", c.Request.Method),
+				slog.String("path", c.Request.URL.Path),
+				slog.Any("error", err))
+			if mode == ValidationStrict {
+				responses.BadRequest(c, err.Error())
+				c.Abort()
+				return
+			}
+		}
+
+		writer := &bufferedWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		output := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 writer.status,
+			Header:                 writer.Header(),
+			Options:                options,
+		}
+		output.SetBodyBytes(writer.body.Bytes())
+		if err := openapi3filter.ValidateResponse(c.Request.Context(), output); err != nil {
+			log.Error("Response does not match OpenAPI document",
+				slog.String("method", c.Request.Method),
+				slog.String("path", c.Request.URL.Path),
+				slog.Int("status", writer.status),
+				slog.Any("error", err))
+			if mode == ValidationStrict {
+				writer.Header().Del("Content-Type")
+				writer.Header().Del("Content-Disposition")
+				responses.InternalError(c, "Response does not match OpenAPI document: "+err.Error())
+				return
+			}
+		}
+		writer.flush()
+	}, nil
+}
+
+// schemaErrorMessage keeps error responses to the failing field rather than
+// the whole schema and value
+func schemaErrorMessage(err *openapi3.SchemaError) string {
+	message := err.Reason
+	if err.Origin != nil {
+		message = err.Origin.Error()
+	}
+	if message == "" {
+		message = `doesn't match schema "` + err.SchemaField + `"`
+	}
+	if path := err.JSONPointer(); len(path) > 0 {
+		return `Error at "/` + strings.Join(path, "/") + `": ` + message
+	}
+	return message
+}
+
+// bufferedWriter holds the response back until it has been validated
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	if !w.written {
+		w.status = status
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.written
+}
+
+// Flush is a no-op until the response is validated
+func (w *bufferedWriter) Flush() {}
+
+func (w *bufferedWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(w.body.Bytes())
+}
@@ -0,0 +1,172 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/openapi"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/projects"
+	companiesService "github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	projectsService "github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newRouter registers the documented routes behind strict validation. The
+// services have no database, so only requests rejected before the service
+// is called can be sent through it.
+func newRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	doc, err := openapi.NewDocument()
+	if err != nil {
+		t.Fatalf("NewDocument: %v", err)
+	}
+	validator, err := openapi.ValidationMiddleware(doc, openapi.ValidationStrict)
+	if err != nil {
+		t.Fatalf("ValidationMiddleware: %v", err)
+	}
+
+	router := gin.New()
+	api := router.Group("/api")
+	api.Use(validator)
+	projects.RegisterRoutes(api, projectsService.NewProjectService(nil, nil, nil))
+	companies.RegisterRoutes(api, companiesService.NewCompanyService(nil, companiesService.NewTemplateRegistry(), nil, nil))
+	return router
+}
+
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func errorMessage(t *testing.T, recorder *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var response responses.ErrorResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode error response %q: %v", recorder.Body.String(), err)
+	}
+	return response.Error
+}
+
+func TestCheckRoutes(t *testing.T) {
+	router := newRouter(t)
+	if err := openapi.CheckRoutes(router.Routes()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckRoutesReportsUndocumentedRoute(t *testing.T) {
+	router := gin.New()
+	projects.RegisterRoutes(router.Group("/api"), projectsService.NewProjectService(nil, nil, nil))
+
+	// Company routes are documented but missing
+	if err := openapi.CheckRoutes(router.Routes()); err == nil {
+		t.Fatal("expected missing company routes to be reported")
+	}
+}
+
+func TestStrictValidationRejectsBadRequests(t *testing.T) {
+	router := newRouter(t)
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		message string
+	}{
+		{"wrong body type", http.MethodPost, "/api/internal/projects", `{"title": 5, "ownerId": "user-1"}`, `Error at "/title"`},
+		{"missing required field", http.MethodPut, "/api/internal/projects/1", `{"title": "Launch"}`, `property "userId" is missing`},
+		{"non-numeric path ID", http.MethodGet, "/api/internal/projects/abc?userId=user-1", "", `parameter "id"`},
+		{"unknown enum value", http.MethodGet, "/api/internal/projects/export?userId=user-1&format=xml", "", `parameter "format"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := serve(router, tt.method, tt.path, tt.body)
+			if recorder.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400: %s", recorder.Code, recorder.Body.String())
+			}
+			message := errorMessage(t, recorder)
+			if !strings.Contains(message, tt.message) {
+				t.Errorf("message %q does not contain %q", message, tt.message)
+			}
+			// Only the failing field is reported, not the whole schema
+			if strings.Contains(message, "Schema:") {
+				t.Errorf("message includes schema details: %q", message)
+			}
+		})
+	}
+}
+
+func TestStrictValidationAcceptsHandlerErrors(t *testing.T) {
+	router := newRouter(t)
+
+	// The handler rejects the request itself; its error envelope must match the document
+	recorder := serve(router, http.MethodGet, "/api/internal/projects/1", "")
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400: %s", recorder.Code, recorder.Body.String())
+	}
+	if message := errorMessage(t, recorder); message != "User ID required" {
+		t.Errorf("message = %q, want %q", message, "User ID required")
+	}
+}
+
+func TestStrictValidationChecksResponses(t *testing.T) {
+	doc, err := openapi.NewDocument()
+	if err != nil {
+		t.Fatalf("NewDocument: %v", err)
+	}
+	validator, err := openapi.ValidationMiddleware(doc, openapi.ValidationStrict)
+	if err != nil {
+		t.Fatalf("ValidationMiddleware: %v", err)
+	}
+
+	now := time.Now()
+	project := projects.ProjectResponse{ID: 1, Title: "Launch", Status: "active", OwnerID: "user-1", CreatedAt: now, UpdatedAt: now}
+	tests := []struct {
+		name   string
+		data   any
+		status int
+	}{
+		{"matching response", project, http.StatusOK},
+		{"drifted response", gin.H{"id": "one", "title": 5}, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(validator)
+			router.GET("/api/internal/projects/:id", func(c *gin.Context) {
+				responses.Success(c, "Project retrieved successfully", tt.data)
+			})
+
+			recorder := serve(router, http.MethodGet, "/api/internal/projects/1?userId=user-1", "")
+			if recorder.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", recorder.Code, tt.status, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestValidationMiddlewareLeavesGlobalsAlone(t *testing.T) {
+	newRouter(t)
+	if openapi3.SchemaErrorDetailsDisabled {
+		t.Fatal("ValidationMiddleware changed openapi3.SchemaErrorDetailsDisabled")
+	}
+}
@@ -0,0 +1,215 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/api/projects"
+	companiesService "github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+)
+
+// operation describes one route of the internal API. Paths use gin syntax so
+// they compare directly against the registered routes.
+type operation struct {
+	method       string
+	path         string
+	summary      string
+	tag          string
+	intParams    []string // path parameters parsed as numeric IDs
+	params       []param
+	body         any  // JSON request body, nil when the route takes none
+	bodyOptional bool // body only carries a fallback for X-User-ID
+	raw          bool // request and response are a CSV or JSON file, not a DTO
+	status       int
+	data         any  // "data" of the success envelope, nil when empty
+	partial      bool // 207 Multi-Status with the same data on partial failure
+}
+
+type param struct {
+	name        string
+	in          string
+	description string
+	enum        []string
+	repeated    bool // may be given more than once
+}
+
+var (
+	userQuery    = param{name: "userId", in: "query", description: "Calling user; X-User-ID is accepted instead"}
+	userHeader   = param{name: "X-User-ID", in: "header", description: "Calling user"}
+	companyQuery = param{name: "companyId", in: "query", description: "Limit to one company's projects"}
+	formatQuery  = param{name: "format", in: "query", description: "File format, defaults to json", enum: []string{"csv", "json"}}
+	dryRunQuery  = param{name: "dryRun", in: "query", description: "Validate every row without writing", enum: []string{"true", "false"}}
+	fieldQuery   = param{name: "field", in: "query", description: "Custom field filter as key:value; all must match", repeated: true}
+	tagQuery     = param{name: "tag", in: "query", description: "Tag name, ignoring case; all must match", repeated: true}
+)
+
+const (
+	projectsTag  = "projects"
+	companiesTag = "companies"
+)
+
+// Response shapes the handlers build inline with gin.H
+type (
+	projectMembersData struct {
+		Members []projects.ProjectMemberResponse `json:"members"`
+		Total   int                              `json:"total"`
+	}
+	projectTeamsData struct {
+		Teams []projects.ProjectTeamResponse `json:"teams"`
+		Total int                            `json:"total"`
+	}
+	clonedProjectData struct {
+		Project projects.ProjectResponse         `json:"project"`
+		Members []projects.ProjectMemberResponse `json:"members"`
+	}
+	companyRolesData struct {
+		Roles []companies.CompanyRoleResponse `json:"roles"`
+		Total int                             `json:"total"`
+	}
+	companyTemplatesData struct {
+		Templates []companiesService.CompanyTemplate `json:"templates"`
+		Total     int                                `json:"total"`
+	}
+)
+
+// Request bodies the handlers extend inline with the calling user
+type (
+	updateProjectBody struct {
+		projects.UpdateProjectRequest
+		UserID string `json:"userId" binding:"required"`
+	}
+	addProjectMemberBody struct {
+		projects.AddMemberRequest
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+	addProjectTeamBody struct {
+		projects.AddTeamRequest
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+	updateCompanyBody struct {
+		companies.UpdateCompanyRequest
+		UserID string `json:"userId" binding:"required"`
+	}
+	addCompanyMemberBody struct {
+		companies.AddMemberRequest
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+	userIDBody struct {
+		UserID string `json:"userId"`
+	}
+	requestingUserIDBody struct {
+		RequestingUserID string `json:"requestingUserId"`
+	}
+)
+
+// operations must match internal/api/projects/routes.go and
+// internal/api/companies/routes.go; CheckRoutes reports any drift
+var operations = []operation{
+	// Project CRUD
+	{method: http.MethodPost, path: "/api/internal/projects", summary: "Create project", tag: projectsTag,
+		body: projects.InternalCreateProjectRequest{}, status: http.StatusCreated, data: projects.ProjectResponse{}},
+	{method: http.MethodGet, path: "/api/internal/projects/:id", summary: "Get project by ID", tag: projectsTag,
+		intParams: []string{"id"}, params: []param{userHeader}, status: http.StatusOK, data: projects.ProjectResponse{}},
+	{method: http.MethodPut, path: "/api/internal/projects/:id", summary: "Update project", tag: projectsTag,
+		intParams: []string{"id"}, body: updateProjectBody{}, status: http.StatusOK, data: projects.ProjectResponse{}},
+	{method: http.MethodDelete, path: "/api/internal/projects/:id", summary: "Delete project", tag: projectsTag,
+		intParams: []string{"id"}, params: []param{userHeader}, body: userIDBody{}, bodyOptional: true, status: http.StatusOK},
+
+	// Project cloning
+	{method: http.MethodPost, path: "/api/internal/projects/:id/clone", summary: "Clone project, optionally with members", tag: projectsTag,
+		intParams: []string{"id"}, body: projects.CloneProjectRequest{}, status: http.StatusCreated, data: clonedProjectData{}},
+
+	// Project hierarchy
+	{method: http.MethodGet, path: "/api/internal/projects/:id/children", summary: "Get direct sub-projects", tag: projectsTag,
+		intParams: []string{"id"}, params: []param{userQuery, userHeader}, status: http.StatusOK, data: projects.ProjectListResponse{}},
+	{method: http.MethodGet, path: "/api/internal/projects/:id/tree", summary: "Get sub-project tree with roll-ups", tag: projectsTag,
+		intParams: []string{"id"}, params: []param{userQuery, userHeader}, status: http.StatusOK, data: projects.ProjectTreeResponse{}},
+	{method: http.MethodPut, path: "/api/internal/projects/:id/parent", summary: "Attach to or detach from a parent", tag: projectsTag,
+		intParams: []string{"id"}, body: projects.MoveProjectRequest{}, status: http.StatusOK, data: projects.ProjectResponse{}},
+
+	// Bulk operations
+	{method: http.MethodPost, path: "/api/internal/projects/bulk/status", summary: "Change status of many projects", tag: projectsTag,
+		body: projects.BulkStatusRequest{}, status: http.StatusOK, data: projects.BulkResponse{}, partial: true},
+	{method: http.MethodPost, path: "/api/internal/projects/bulk/owner", summary: "Reassign owner of many projects", tag: projectsTag,
+		body: projects.BulkOwnerRequest{}, status: http.StatusOK, data: projects.BulkResponse{}, partial: true},
+	{method: http.MethodPost, path: "/api/internal/projects/bulk/company", summary: "Move many projects to a company", tag: projectsTag,
+		body: projects.BulkCompanyRequest{}, status: http.StatusOK, data: projects.BulkResponse{}, partial: true},
+	{method: http.MethodPost, path: "/api/internal/projects/bulk/delete", summary: "Delete many projects", tag: projectsTag,
+		body: projects.BulkProjectsRequest{}, status: http.StatusOK, data: projects.BulkResponse{}, partial: true},
+	{method: http.MethodPost, path: "/api/internal/projects/bulk/members/add", summary: "Add one user to many projects", tag: projectsTag,
+		body: projects.BulkMemberRequest{}, status: http.StatusOK, data: projects.BulkResponse{}, partial: true},
+	{method: http.MethodPost, path: "/api/internal/projects/bulk/members/remove", summary: "Remove one user from many projects", tag: projectsTag,
+		body: projects.BulkMemberRequest{}, status: http.StatusOK, data: projects.BulkResponse{}, partial: true},
+
+	// Import and export
+	{method: http.MethodGet, path: "/api/internal/projects/export", summary: "Export projects with members", tag: projectsTag,
+		params: []param{userQuery, userHeader, companyQuery, formatQuery}, raw: true, status: http.StatusOK},
+	{method: http.MethodPost, path: "/api/internal/projects/import", summary: "Upsert projects by external key", tag: projectsTag,
+		params: []param{userQuery, userHeader, companyQuery, formatQuery, dryRunQuery}, raw: true, status: http.StatusOK, data: projects.ImportResponse{}, partial: true},
+
+	// User projects
+	{method: http.MethodGet, path: "/api/internal/projects", summary: "Get user's projects", tag: projectsTag,
+		params: []param{userQuery, userHeader, fieldQuery, tagQuery}, status: http.StatusOK, data: projects.ProjectListResponse{}},
+
+	// Project members
+	{method: http.MethodGet, path: "/api/internal/projects/:id/members", summary: "Get project members", tag: projectsTag,
+		intParams: []string{"id"}, params: []param{userQuery, userHeader}, status: http.StatusOK, data: projectMembersData{}},
+	{method: http.MethodPost, path: "/api/internal/projects/:id/members", summary: "Add member to project", tag: projectsTag,
+		intParams: []string{"id"}, body: addProjectMemberBody{}, status: http.StatusCreated, data: projects.ProjectMemberResponse{}},
+	{method: http.MethodPut, path: "/api/internal/projects/:id/members/:userId", summary: "Change a member's role or permissions", tag: projectsTag,
+		intParams: []string{"id"}, body: projects.UpdateMemberRequest{}, status: http.StatusOK, data: projects.ProjectMemberResponse{}},
+
+	// Project teams
+	{method: http.MethodGet, path: "/api/internal/projects/:id/teams", summary: "Get teams granted on project", tag: projectsTag,
+		intParams: []string{"id"}, params: []param{userQuery, userHeader}, status: http.StatusOK, data: projectTeamsData{}},
+	{method: http.MethodPost, path: "/api/internal/projects/:id/teams", summary: "Grant a team a role on project", tag: projectsTag,
+		intParams: []string{"id"}, body: addProjectTeamBody{}, status: http.StatusCreated, data: projects.ProjectTeamResponse{}},
+	{method: http.MethodDelete, path: "/api/internal/projects/:id/teams/:teamId", summary: "Revoke a team's access", tag: projectsTag,
+		intParams: []string{"id", "teamId"}, params: []param{userHeader}, body: requestingUserIDBody{}, bodyOptional: true, status: http.StatusOK},
+
+	// Company CRUD
+	{method: http.MethodPost, path: "/api/internal/companies", summary: "Create company", tag: companiesTag,
+		body: companies.InternalCreateCompanyRequest{}, status: http.StatusCreated, data: companies.CompanyResponse{}},
+	{method: http.MethodGet, path: "/api/internal/companies/:id", summary: "Get company by ID", tag: companiesTag,
+		params: []param{userHeader}, status: http.StatusOK, data: companies.CompanyResponse{}},
+	{method: http.MethodPut, path: "/api/internal/companies/:id", summary: "Update company", tag: companiesTag,
+		body: updateCompanyBody{}, status: http.StatusOK, data: companies.CompanyResponse{}},
+	{method: http.MethodDelete, path: "/api/internal/companies/:id", summary: "Delete company", tag: companiesTag,
+		params: []param{userHeader}, body: userIDBody{}, bodyOptional: true, status: http.StatusOK},
+
+	// User companies
+	{method: http.MethodGet, path: "/api/internal/companies", summary: "Get user's companies", tag: companiesTag,
+		params: []param{userQuery, userHeader}, status: http.StatusOK, data: companies.CompanyListResponse{}},
+
+	// Company members
+	{method: http.MethodGet, path: "/api/internal/companies/:id/members", summary: "Get company members", tag: companiesTag,
+		params: []param{userQuery, userHeader}, status: http.StatusOK, data: companies.MemberListResponse{}},
+	{method: http.MethodPost, path: "/api/internal/companies/:id/members", summary: "Add member to company", tag: companiesTag,
+		body: addCompanyMemberBody{}, status: http.StatusCreated, data: companies.CompanyMemberResponse{}},
+	{method: http.MethodDelete, path: "/api/internal/companies/:id/members/:userId", summary: "Remove member from company", tag: companiesTag,
+		params: []param{userHeader}, body: requestingUserIDBody{}, bodyOptional: true, status: http.StatusOK},
+
+	// Member roles and invitations
+	{method: http.MethodPut, path: "/api/internal/companies/:id/members/:userId/role", summary: "Change a member's role", tag: companiesTag,
+		body: companies.UpdateMemberRoleRequest{}, status: http.StatusOK, data: companies.CompanyMemberResponse{}},
+	{method: http.MethodPost, path: "/api/internal/companies/:id/invitations", summary: "Invite a user; they join on accepting", tag: companiesTag,
+		body: companies.InviteMemberRequest{}, status: http.StatusCreated, data: companies.CompanyMemberResponse{}},
+	{method: http.MethodPost, path: "/api/internal/companies/:id/invitations/accept", summary: "Accept an invitation", tag: companiesTag,
+		body: companies.AcceptInvitationRequest{}, status: http.StatusOK, data: companies.CompanyMemberResponse{}},
+	{method: http.MethodGet, path: "/api/internal/companies/invitations", summary: "Pending invitations", tag: companiesTag,
+		params: []param{userQuery, userHeader}, status: http.StatusOK, data: companies.MemberListResponse{}},
+
+	// Member compensation
+	{method: http.MethodGet, path: "/api/internal/companies/:id/members/:userId/compensation", summary: "Rate history", tag: companiesTag,
+		params: []param{userQuery, userHeader}, status: http.StatusOK, data: companies.CompensationListResponse{}},
+	{method: http.MethodPut, path: "/api/internal/companies/:id/members/:userId/compensation", summary: "Record an effective-dated rate change", tag: companiesTag,
+		body: companies.SetCompensationRequest{}, status: http.StatusCreated, data: companies.CompensationRateResponse{}},
+
+	// Company roles
+	{method: http.MethodGet, path: "/api/internal/companies/:id/roles", summary: "Get roles seeded from the company template", tag: companiesTag,
+		params: []param{userQuery, userHeader}, status: http.StatusOK, data: companyRolesData{}},
+
+	// Company templates keyed on company type
+	{method: http.MethodGet, path: "/api/internal/company-templates", summary: "List company templates", tag: companiesTag,
+		status: http.StatusOK, data: companyTemplatesData{}},
+}
@@ -0,0 +1,16 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes serves the OpenAPI document
+func RegisterRoutes(router *gin.RouterGroup, doc *openapi3.T) {
+	// Served bare rather than in the response envelope so tooling can load it directly
+	router.GET(DocumentPath, func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	})
+}
@@ -0,0 +1,130 @@
+package openapi
+
+import (
+	"go/token"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaBuilder derives JSON schemas from the API DTOs the same way
+// encoding/json and gin's binding see them. Named structs become components
+// so the document reads like the Go types; unexported, anonymous and generic
+// ones are inlined.
+type schemaBuilder struct {
+	components openapi3.Schemas
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{components: openapi3.Schemas{}}
+}
+
+// request schemas only require fields tagged binding:"required"
+func (b *schemaBuilder) request(value any) *openapi3.SchemaRef {
+	return b.ref(reflect.TypeOf(value), false)
+}
+
+// response schemas also require every field that is always encoded
+func (b *schemaBuilder) response(value any) *openapi3.SchemaRef {
+	return b.ref(reflect.TypeOf(value), true)
+}
+
+func (b *schemaBuilder) ref(t reflect.Type, response bool) *openapi3.SchemaRef {
+	switch {
+	case t.Kind() == reflect.Pointer:
+		schema := b.ref(t.Elem(), response)
+		if schema.Ref != "" {
+			// Siblings of $ref are ignored in 3.0, so wrap it to carry nullable
+			return openapi3.NewSchemaRef("", &openapi3.Schema{AllOf: openapi3.SchemaRefs{schema}, Nullable: true})
+		}
+		nullable := *schema.Value
+		nullable.Nullable = true
+		return openapi3.NewSchemaRef("", &nullable)
+	case t == timeType:
+		return openapi3.NewSchemaRef("", openapi3.NewDateTimeSchema())
+	case t.Kind() == reflect.Struct && token.IsExported(t.Name()) && !strings.Contains(t.Name(), "["):
+		name := componentName(t)
+		if _, ok := b.components[name]; !ok {
+			// Register before recursing so self-referencing trees terminate
+			b.components[name] = openapi3.NewSchemaRef("", &openapi3.Schema{})
+			*b.components[name].Value = *b.object(t, response)
+		}
+		return openapi3.NewSchemaRef("#/components/schemas/"+name, b.components[name].Value)
+	}
+	return openapi3.NewSchemaRef("", b.schema(t, response))
+}
+
+func (b *schemaBuilder) schema(t reflect.Type, response bool) *openapi3.Schema {
+	switch t.Kind() {
+	case reflect.Bool:
+		return openapi3.NewBoolSchema()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return openapi3.NewIntegerSchema()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return openapi3.NewIntegerSchema().WithMin(0)
+	case reflect.Float32, reflect.Float64:
+		return openapi3.NewFloat64Schema()
+	case reflect.String:
+		return openapi3.NewStringSchema()
+	case reflect.Slice, reflect.Array:
+		schema := openapi3.NewArraySchema()
+		schema.Items = b.ref(t.Elem(), response)
+		// A nil slice encodes as null
+		schema.Nullable = t.Kind() == reflect.Slice
+		return schema
+	case reflect.Map:
+		schema := openapi3.NewObjectSchema()
+		schema.AdditionalProperties = openapi3.AdditionalProperties{Schema: b.ref(t.Elem(), response)}
+		return schema
+	case reflect.Struct:
+		return b.object(t, response)
+	}
+	// interface{} and anything else encoding/json can hold
+	return &openapi3.Schema{}
+}
+
+func (b *schemaBuilder) object(t reflect.Type, response bool) *openapi3.Schema {
+	schema := openapi3.NewObjectSchema()
+	schema.Properties = openapi3.Schemas{}
+	b.fields(schema, t, response)
+	return schema
+}
+
+// fields adds t's JSON fields to schema, flattening embedded structs
+func (b *schemaBuilder) fields(schema *openapi3.Schema, t reflect.Type, response bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			b.fields(schema, field.Type, response)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties[name] = b.ref(field.Type, response)
+		omitempty := strings.Contains(options, "omitempty")
+		if strings.Contains(field.Tag.Get("binding"), "required") || (response && !omitempty) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// componentName qualifies the type with its package, since projects and
+// companies both declare an AddMemberRequest
+func componentName(t reflect.Type) string {
+	path := t.PkgPath()
+	return path[strings.LastIndex(path, "/")+1:] + "." + t.Name()
+}
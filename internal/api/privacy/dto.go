@@ -0,0 +1,136 @@
+package privacy
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/privacy"
+)
+
+// Request DTOs
+type EraseUserRequest struct {
+	RequestingUserID   string `json:"requestingUserId" binding:"required"`
+	PersonalProjects   string `json:"personalProjects"` // delete (default) or anonymize
+	RetainCompensation bool   `json:"retainCompensation"`
+	DryRun             bool   `json:"dryRun"`
+}
+
+// Response DTOs - the report carries stored records as they are, for portability
+type SubjectAccessResponse struct {
+	UserID              string                      `json:"userId"`
+	GeneratedAt         time.Time                   `json:"generatedAt"`
+	OwnedCompanies      []db.Company                `json:"ownedCompanies"`
+	CompanyMemberships  []db.CompanyMember          `json:"companyMemberships"`
+	InvitationsSent     []db.CompanyMember          `json:"invitationsSent"`
+	CompensationHistory []db.CompensationRate       `json:"compensationHistory"`
+	OwnedProjects       []db.BaseProject            `json:"ownedProjects"`
+	ProjectMemberships  []db.ProjectMember          `json:"projectMemberships"`
+	TeamMemberships     []db.TeamMember             `json:"teamMemberships"`
+	TeamsCreated        []db.Team                   `json:"teamsCreated"`
+	OwnedTemplates      []db.ProjectTemplate        `json:"ownedTemplates"`
+	TemplateMemberships []db.ProjectTemplateMember  `json:"templateMemberships"`
+	OwnedTags           []db.Tag                    `json:"ownedTags"`
+	CalendarTokens      []db.CalendarToken          `json:"calendarTokens"`
+	Notifications       []db.Notification           `json:"notifications"`
+	NotificationPrefs   []db.NotificationPreference `json:"notificationPreferences"`
+	EmailSettings       []db.EmailSettings          `json:"emailSettings"`
+	EmailMessages       []db.EmailMessage           `json:"emailMessages"`
+	ChangeEvents        []db.ChangeEvent            `json:"changeEvents"`
+	ProjectPresence     []db.ProjectPresence        `json:"projectPresence"`
+}
+
+type ErasureActionResponse struct {
+	Table  string `json:"table"`
+	Column string `json:"column"`
+	Action string `json:"action"`
+	Count  int64  `json:"count"`
+}
+
+type ErasureRecordResponse struct {
+	ID          uint            `json:"id"`
+	SubjectHash string          `json:"subjectHash"`
+	Pseudonym   string          `json:"pseudonym"`
+	Policy      json.RawMessage `json:"policy"`
+	Actions     json.RawMessage `json:"actions"`
+	PerformedAt time.Time       `json:"performedAt"`
+	Digest      string          `json:"digest"`
+}
+
+type ErasureResponse struct {
+	DryRun  bool                    `json:"dryRun"`
+	Actions []ErasureActionResponse `json:"actions"`
+	Record  *ErasureRecordResponse  `json:"record,omitempty"`
+}
+
+type VerifyResponse struct {
+	Record ErasureRecordResponse `json:"record"`
+	Valid  bool                  `json:"valid"` // Digest matches the stored fields
+}
+
+func (r *EraseUserRequest) ToPolicy() privacy.ErasurePolicy {
+	return privacy.ErasurePolicy{
+		PersonalProjects:   r.PersonalProjects,
+		RetainCompensation: r.RetainCompensation,
+	}
+}
+
+func SubjectAccessToResponse(report *privacy.SubjectAccessReport) SubjectAccessResponse {
+	return SubjectAccessResponse{
+		UserID:              report.UserID,
+		GeneratedAt:         report.GeneratedAt,
+		OwnedCompanies:      report.OwnedCompanies,
+		CompanyMemberships:  report.CompanyMemberships,
+		InvitationsSent:     report.InvitationsSent,
+		CompensationHistory: report.CompensationHistory,
+		OwnedProjects:       report.OwnedProjects,
+		ProjectMemberships:  report.ProjectMemberships,
+		TeamMemberships:     report.TeamMemberships,
+		TeamsCreated:        report.TeamsCreated,
+		OwnedTemplates:      report.OwnedTemplates,
+		TemplateMemberships: report.TemplateMemberships,
+		OwnedTags:           report.OwnedTags,
+		CalendarTokens:      report.CalendarTokens,
+		Notifications:       report.Notifications,
+		NotificationPrefs:   report.NotificationPrefs,
+		EmailSettings:       report.EmailSettings,
+		EmailMessages:       report.EmailMessages,
+		ChangeEvents:        report.ChangeEvents,
+		ProjectPresence:     report.ProjectPresence,
+	}
+}
+
+func ErasureRecordToResponse(record *db.ErasureRecord) ErasureRecordResponse {
+	return ErasureRecordResponse{
+		ID:          record.ID,
+		SubjectHash: record.SubjectHash,
+		Pseudonym:   record.Pseudonym,
+		Policy:      json.RawMessage(record.Policy),
+		Actions:     json.RawMessage(record.Actions),
+		PerformedAt: record.PerformedAt,
+		Digest:      record.Digest,
+	}
+}
+
+func ErasureRecordsToResponse(records []db.ErasureRecord) []ErasureRecordResponse {
+	responses := make([]ErasureRecordResponse, len(records))
+	for i, record := range records {
+		responses[i] = ErasureRecordToResponse(&record)
+	}
+	return responses
+}
+
+func ErasureResultToResponse(result *privacy.ErasureResult) ErasureResponse {
+	response := ErasureResponse{
+		DryRun:  result.DryRun,
+		Actions: make([]ErasureActionResponse, len(result.Actions)),
+	}
+	for i, action := range result.Actions {
+		response.Actions[i] = ErasureActionResponse(action)
+	}
+	if result.Record != nil {
+		record := ErasureRecordToResponse(result.Record)
+		response.Record = &record
+	}
+	return response
+}
@@ -0,0 +1,124 @@
+package privacy
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/privacy"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type PrivacyHandler struct {
+	privacyService *privacy.PrivacyService
+}
+
+func NewPrivacyHandler(privacyService *privacy.PrivacyService) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService: privacyService,
+	}
+}
+
+func (h *PrivacyHandler) GetSubjectAccessReport(c *gin.Context) {
+	subjectID := c.Param("id")
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	report, err := h.privacyService.GetSubjectAccessReport(subjectID, userID)
+	if err != nil {
+		if err.Error() == "users can only access their own data" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := SubjectAccessToResponse(report)
+	responses.Success(c, "Subject access report generated successfully", response)
+}
+
+func (h *PrivacyHandler) EraseUser(c *gin.Context) {
+	subjectID := c.Param("id")
+
+	var req EraseUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.privacyService.EraseUser(subjectID, req.ToPolicy(), req.DryRun, req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "users can only erase their own data" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "user still owns companies" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		if err.Error() == "invalid personal projects policy" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := ErasureResultToResponse(result)
+	if result.DryRun {
+		responses.Success(c, "Erasure plan generated successfully", response)
+		return
+	}
+	responses.Success(c, "User erased successfully", response)
+}
+
+func (h *PrivacyHandler) GetErasureRecords(c *gin.Context) {
+	subjectID := c.Query("userId")
+	if subjectID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	records, err := h.privacyService.GetErasureRecords(subjectID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	recordResponses := ErasureRecordsToResponse(records)
+	response := types.ListResponse[ErasureRecordResponse]{
+		Data: recordResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(recordResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Erasure records retrieved successfully", response)
+}
+
+func (h *PrivacyHandler) VerifyErasureRecord(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid erasure record ID")
+		return
+	}
+
+	record, valid, err := h.privacyService.VerifyErasureRecord(uint(id))
+	if err != nil {
+		responses.NotFound(c, err.Error())
+		return
+	}
+
+	response := VerifyResponse{Record: ErasureRecordToResponse(record), Valid: valid}
+	responses.Success(c, "Erasure record verified", response)
+}
@@ -0,0 +1,21 @@
+package privacy
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/privacy"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers data subject access and erasure routes
+func RegisterRoutes(router *gin.RouterGroup, privacyService *privacy.PrivacyService) {
+	handler := NewPrivacyHandler(privacyService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/privacy")
+	{
+		internal.GET("/users/:id/report", handler.GetSubjectAccessReport) // Everything stored under a user ID (query: userId)
+		internal.POST("/users/:id/erasure", handler.EraseUser)            // Erase or anonymize a user's data, optionally as a dry run
+
+		internal.GET("/erasures", handler.GetErasureRecords)              // Erasure records for a user ID (query: userId)
+		internal.GET("/erasures/:id/verify", handler.VerifyErasureRecord) // Recompute an erasure record's digest
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
 	"github.com/JorgeSaicoski/microservice-commons/types"
 )
 
@@ -13,9 +14,13 @@ type CreateProjectRequest struct {
 	Description *string    `json:"description"`
 	Status      string     `json:"status"`
 	CompanyID   *string    `json:"companyId"`
+	ParentID    *uint      `json:"parentId"`
 	StartDate   *time.Time `json:"startDate"`
 	EndDate     *time.Time `json:"endDate"`
 	OwnerID     string     `json:"ownerId" binding:"required"`
+	ProjectType *string    `json:"projectType"` // Registered type of the module extending the project
+
+	CustomFields map[string]interface{} `json:"customFields"`
 }
 
 type UpdateProjectRequest struct {
@@ -24,6 +29,8 @@ type UpdateProjectRequest struct {
 	Status      string     `json:"status"`
 	StartDate   *time.Time `json:"startDate"`
 	EndDate     *time.Time `json:"endDate"`
+
+	CustomFields map[string]interface{} `json:"customFields"` // Merged into the current values; null clears a field
 }
 
 type AddMemberRequest struct {
@@ -32,14 +39,73 @@ type AddMemberRequest struct {
 	Permissions []string `json:"permissions"`
 }
 
+type UpdateMemberRequest struct {
+	RequestingUserID string   `json:"requestingUserId" binding:"required"`
+	Role             string   `json:"role"`        // Omit to keep the current role
+	Permissions      []string `json:"permissions"` // Omit to keep the current permissions
+}
+
+type AddTeamRequest struct {
+	TeamID      uint     `json:"teamId" binding:"required"`
+	Role        string   `json:"role" binding:"required"`
+	Permissions []string `json:"permissions"`
+}
+
 type InternalCreateProjectRequest struct {
 	Title       string     `json:"title"`
 	Description *string    `json:"description"`
 	Status      string     `json:"status"`
 	OwnerID     string     `json:"ownerId"`
 	CompanyID   *string    `json:"companyId"`
+	ParentID    *uint      `json:"parentId"`
 	StartDate   *time.Time `json:"startDate"`
 	EndDate     *time.Time `json:"endDate"`
+	ProjectType *string    `json:"projectType"` // Registered type of the module extending the project
+
+	CustomFields map[string]interface{} `json:"customFields"`
+}
+
+type CloneProjectRequest struct {
+	Title          string     `json:"title"`
+	StartDate      *time.Time `json:"startDate"`
+	IncludeMembers bool       `json:"includeMembers"`
+	UserID         string     `json:"userId" binding:"required"`
+}
+
+// Bulk request DTOs - atomic applies all items or none
+type BulkProjectsRequest struct {
+	ProjectIDs []uint `json:"projectIds" binding:"required"`
+	Atomic     bool   `json:"atomic"`
+	UserID     string `json:"userId" binding:"required"`
+}
+
+type BulkStatusRequest struct {
+	BulkProjectsRequest
+	Status string `json:"status" binding:"required"`
+}
+
+type BulkOwnerRequest struct {
+	BulkProjectsRequest
+	OwnerID string `json:"ownerId" binding:"required"`
+}
+
+type BulkCompanyRequest struct {
+	BulkProjectsRequest
+	CompanyID *string `json:"companyId"` // null makes the projects personal
+}
+
+type BulkMemberRequest struct {
+	ProjectIDs       []uint   `json:"projectIds" binding:"required"`
+	Atomic           bool     `json:"atomic"`
+	UserID           string   `json:"userId" binding:"required"`
+	Role             string   `json:"role"`
+	Permissions      []string `json:"permissions"`
+	RequestingUserID string   `json:"requestingUserId" binding:"required"`
+}
+
+type MoveProjectRequest struct {
+	ParentID *uint  `json:"parentId"` // null detaches the project to the top level
+	UserID   string `json:"userId" binding:"required"`
 }
 
 // Response DTOs - use types from microservice-commons
@@ -50,10 +116,16 @@ type ProjectResponse struct {
 	Status      string     `json:"status"`
 	OwnerID     string     `json:"ownerId"`
 	CompanyID   *string    `json:"companyId"`
+	ParentID    *uint      `json:"parentId"`
 	StartDate   *time.Time `json:"startDate"`
 	EndDate     *time.Time `json:"endDate"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	ExternalKey *string    `json:"externalKey,omitempty"`
+	ProjectType *string    `json:"projectType,omitempty"`
 	CreatedAt   time.Time  `json:"createdAt"`
 	UpdatedAt   time.Time  `json:"updatedAt"`
+
+	CustomFields map[string]interface{} `json:"customFields,omitempty"`
 }
 
 type ProjectMemberResponse struct {
@@ -65,46 +137,110 @@ type ProjectMemberResponse struct {
 	JoinedAt    time.Time `json:"joinedAt"`
 }
 
+type ProjectTeamResponse struct {
+	ID          uint      `json:"id"`
+	ProjectID   uint      `json:"projectId"`
+	TeamID      uint      `json:"teamId"`
+	Role        string    `json:"role"`
+	Permissions []string  `json:"permissions"`
+	GrantedBy   string    `json:"grantedBy"`
+	GrantedAt   time.Time `json:"grantedAt"`
+}
+
+type ProjectRollupResponse struct {
+	Status       string     `json:"status"`
+	StartDate    *time.Time `json:"startDate"`
+	EndDate      *time.Time `json:"endDate"`
+	ProjectCount int        `json:"projectCount"`
+}
+
+type ProjectTreeResponse struct {
+	ProjectResponse
+	Rollup   ProjectRollupResponse `json:"rollup"`
+	Children []ProjectTreeResponse `json:"children"`
+}
+
+type BulkItemResponse struct {
+	ProjectID uint   `json:"projectId"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+type BulkResponse struct {
+	Results    []BulkItemResponse `json:"results"`
+	Succeeded  int                `json:"succeeded"`
+	Failed     int                `json:"failed"`
+	Atomic     bool               `json:"atomic"`
+	RolledBack bool               `json:"rolledBack"`
+}
+
+type ImportRowResponse struct {
+	Row         int    `json:"row"`
+	ExternalKey string `json:"externalKey,omitempty"`
+	Action      string `json:"action"`
+	ProjectID   *uint  `json:"projectId,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type ImportResponse struct {
+	DryRun  bool                `json:"dryRun"`
+	Rows    []ImportRowResponse `json:"rows"`
+	Created int                 `json:"created"`
+	Updated int                 `json:"updated"`
+	Failed  int                 `json:"failed"`
+}
+
 // Use standardized list response
 type ProjectListResponse = types.ListResponse[ProjectResponse]
 
 // Conversion methods remain the same
 func (r *CreateProjectRequest) ToProject() *db.BaseProject {
 	return &db.BaseProject{
-		Title:       r.Title,
-		Description: r.Description,
-		Status:      r.Status,
-		OwnerID:     r.OwnerID,
-		CompanyID:   r.CompanyID,
-		StartDate:   r.StartDate,
-		EndDate:     r.EndDate,
+		Title:        r.Title,
+		Description:  r.Description,
+		Status:       r.Status,
+		OwnerID:      r.OwnerID,
+		CompanyID:    r.CompanyID,
+		ParentID:     r.ParentID,
+		StartDate:    r.StartDate,
+		EndDate:      r.EndDate,
+		ProjectType:  r.ProjectType,
+		CustomFields: r.CustomFields,
 	}
 }
 
 func (r *InternalCreateProjectRequest) ToProject() *db.BaseProject {
 	return &db.BaseProject{
-		Title:       r.Title,
-		Description: r.Description,
-		Status:      r.Status,
-		OwnerID:     r.OwnerID,
-		CompanyID:   r.CompanyID,
-		StartDate:   r.StartDate,
-		EndDate:     r.EndDate,
+		Title:        r.Title,
+		Description:  r.Description,
+		Status:       r.Status,
+		OwnerID:      r.OwnerID,
+		CompanyID:    r.CompanyID,
+		ParentID:     r.ParentID,
+		StartDate:    r.StartDate,
+		EndDate:      r.EndDate,
+		ProjectType:  r.ProjectType,
+		CustomFields: r.CustomFields,
 	}
 }
 
 func ProjectToResponse(project *db.BaseProject) ProjectResponse {
 	return ProjectResponse{
-		ID:          project.ID,
-		Title:       project.Title,
-		Description: project.Description,
-		Status:      project.Status,
-		OwnerID:     project.OwnerID,
-		CompanyID:   project.CompanyID,
-		StartDate:   project.StartDate,
-		EndDate:     project.EndDate,
-		CreatedAt:   project.CreatedAt,
-		UpdatedAt:   project.UpdatedAt,
+		ID:           project.ID,
+		Title:        project.Title,
+		Description:  project.Description,
+		Status:       project.Status,
+		OwnerID:      project.OwnerID,
+		CompanyID:    project.CompanyID,
+		ParentID:     project.ParentID,
+		StartDate:    project.StartDate,
+		EndDate:      project.EndDate,
+		CompletedAt:  project.CompletedAt,
+		ExternalKey:  project.ExternalKey,
+		ProjectType:  project.ProjectType,
+		CreatedAt:    project.CreatedAt,
+		UpdatedAt:    project.UpdatedAt,
+		CustomFields: project.CustomFields,
 	}
 }
 
@@ -116,6 +252,23 @@ func ProjectsToResponse(projects []db.BaseProject) []ProjectResponse {
 	return responses
 }
 
+func ProjectTreeToResponse(node *projects.ProjectNode) ProjectTreeResponse {
+	children := make([]ProjectTreeResponse, len(node.Children))
+	for i, child := range node.Children {
+		children[i] = ProjectTreeToResponse(child)
+	}
+	return ProjectTreeResponse{
+		ProjectResponse: ProjectToResponse(&node.Project),
+		Rollup: ProjectRollupResponse{
+			Status:       node.Rollup.Status,
+			StartDate:    node.Rollup.StartDate,
+			EndDate:      node.Rollup.EndDate,
+			ProjectCount: node.Rollup.ProjectCount,
+		},
+		Children: children,
+	}
+}
+
 func MemberToResponse(member *db.ProjectMember) ProjectMemberResponse {
 	return ProjectMemberResponse{
 		ProjectID:   member.ProjectID,
@@ -134,3 +287,61 @@ func MembersToResponse(members []db.ProjectMember) []ProjectMemberResponse {
 	}
 	return responses
 }
+
+func ProjectTeamToResponse(grant *db.ProjectTeam) ProjectTeamResponse {
+	return ProjectTeamResponse{
+		ID:          grant.ID,
+		ProjectID:   grant.ProjectID,
+		TeamID:      grant.TeamID,
+		Role:        grant.Role,
+		Permissions: grant.Permissions,
+		GrantedBy:   grant.GrantedBy,
+		GrantedAt:   grant.GrantedAt,
+	}
+}
+
+func ProjectTeamsToResponse(grants []db.ProjectTeam) []ProjectTeamResponse {
+	responses := make([]ProjectTeamResponse, len(grants))
+	for i, grant := range grants {
+		responses[i] = ProjectTeamToResponse(&grant)
+	}
+	return responses
+}
+
+func BulkResultToResponse(result *projects.BulkResult) BulkResponse {
+	items := make([]BulkItemResponse, len(result.Results))
+	for i, item := range result.Results {
+		items[i] = BulkItemResponse{
+			ProjectID: item.ProjectID,
+			Success:   item.Success,
+			Error:     item.Error,
+		}
+	}
+	return BulkResponse{
+		Results:    items,
+		Succeeded:  result.Succeeded,
+		Failed:     result.Failed,
+		Atomic:     result.Atomic,
+		RolledBack: result.RolledBack,
+	}
+}
+
+func ImportResultToResponse(result *projects.ImportResult) ImportResponse {
+	rows := make([]ImportRowResponse, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = ImportRowResponse{
+			Row:         row.Row,
+			ExternalKey: row.ExternalKey,
+			Action:      row.Action,
+			ProjectID:   row.ProjectID,
+			Error:       row.Error,
+		}
+	}
+	return ImportResponse{
+		DryRun:  result.DryRun,
+		Rows:    rows,
+		Created: result.Created,
+		Updated: result.Updated,
+		Failed:  result.Failed,
+	}
+}
@@ -1,7 +1,10 @@
 package projects
 
 import (
+	"errors"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/JorgeSaicoski/go-project-manager/internal/db"
@@ -30,6 +33,15 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 
 	project, err := h.projectService.CreateProject(req.ToProject())
 	if err != nil {
+		if err.Error() == "user cannot add sub-projects to this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "parent project not found" || err.Error() == "sub-project must belong to the parent's company" ||
+			err.Error() == "project type not found" || isCustomFieldError(err) {
+			responses.BadRequest(c, err.Error())
+			return
+		}
 		responses.InternalError(c, err.Error())
 		return
 	}
@@ -95,11 +107,12 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 
 	updates := &req.UpdateProjectRequest
 	projectUpdates := &db.BaseProject{
-		Title:       updates.Title,
-		Description: updates.Description,
-		Status:      updates.Status,
-		StartDate:   updates.StartDate,
-		EndDate:     updates.EndDate,
+		Title:        updates.Title,
+		Description:  updates.Description,
+		Status:       updates.Status,
+		StartDate:    updates.StartDate,
+		EndDate:      updates.EndDate,
+		CustomFields: updates.CustomFields,
 	}
 
 	project, err := h.projectService.UpdateProject(uint(id), projectUpdates, req.UserID)
@@ -108,6 +121,10 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 			responses.Forbidden(c, err.Error())
 			return
 		}
+		if isCustomFieldError(err) {
+			responses.BadRequest(c, err.Error())
+			return
+		}
 		responses.InternalError(c, err.Error())
 		return
 	}
@@ -145,6 +162,10 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 			responses.Forbidden(c, err.Error())
 			return
 		}
+		if err.Error() == "project has sub-projects" {
+			responses.Conflict(c, err.Error())
+			return
+		}
 		responses.InternalError(c, err.Error())
 		return
 	}
@@ -163,7 +184,13 @@ func (h *ProjectHandler) GetUserProjects(c *gin.Context) {
 		return
 	}
 
-	projects, err := h.projectService.GetUserProjects(userID)
+	filter, err := parseProjectFilter(c)
+	if err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	projects, err := h.projectService.GetUserProjects(userID, filter)
 	if err != nil {
 		responses.InternalError(c, err.Error())
 		return
@@ -223,6 +250,42 @@ func (h *ProjectHandler) AddProjectMember(c *gin.Context) {
 	responses.Created(c, "Member added successfully", response)
 }
 
+func (h *ProjectHandler) UpdateProjectMember(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	var req UpdateMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.projectService.UpdateProjectMember(uint(id), c.Param("userId"), req.Role, req.Permissions, req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot update members of this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "user is not a member of this project" {
+			responses.NotFound(c, err.Error())
+			return
+		}
+		if err.Error() == "record not found" {
+			responses.NotFound(c, "Project not found")
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Success(c, "Member updated successfully", response)
+}
+
 func (h *ProjectHandler) GetProjectMembers(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
@@ -257,3 +320,505 @@ func (h *ProjectHandler) GetProjectMembers(c *gin.Context) {
 		"total":   len(memberResponses),
 	})
 }
+
+func (h *ProjectHandler) AddProjectTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	var req struct {
+		AddTeamRequest
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	grant, err := h.projectService.AddProjectTeam(
+		uint(id),
+		req.TeamID,
+		req.Role,
+		req.Permissions,
+		req.RequestingUserID,
+	)
+	if err != nil {
+		if err.Error() == "user cannot add members to this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "team and project belong to different companies" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		if err.Error() == "team already has access to this project" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := ProjectTeamToResponse(grant)
+	responses.Created(c, "Team added successfully", response)
+}
+
+func (h *ProjectHandler) RemoveProjectTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	teamID, err := strconv.ParseUint(c.Param("teamId"), 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+
+	requestingUserID := c.GetHeader("X-User-ID")
+	if requestingUserID == "" {
+		var req struct {
+			RequestingUserID string `json:"requestingUserId"`
+		}
+		if err := c.ShouldBindJSON(&req); err == nil {
+			requestingUserID = req.RequestingUserID
+		}
+	}
+
+	if requestingUserID == "" {
+		responses.BadRequest(c, "Requesting User ID required")
+		return
+	}
+
+	err = h.projectService.RemoveProjectTeam(uint(id), uint(teamID), requestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot remove members from this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Team removed successfully", nil)
+}
+
+func (h *ProjectHandler) GetProjectTeams(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	grants, err := h.projectService.GetProjectTeams(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot access this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	teamResponses := ProjectTeamsToResponse(grants)
+	responses.Success(c, "Teams retrieved successfully", gin.H{
+		"teams": teamResponses,
+		"total": len(teamResponses),
+	})
+}
+
+func (h *ProjectHandler) GetProjectChildren(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	children, err := h.projectService.GetProjectChildren(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot access this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.NotFound(c, err.Error())
+		return
+	}
+
+	projectResponses := ProjectsToResponse(children)
+	response := types.ListResponse[ProjectResponse]{
+		Data: projectResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(projectResponses),
+			Timestamp: time.Now(),
+		},
+	}
+
+	responses.Success(c, "Sub-projects retrieved successfully", response)
+}
+
+func (h *ProjectHandler) GetProjectTree(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	tree, err := h.projectService.GetProjectTree(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot access this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.NotFound(c, err.Error())
+		return
+	}
+
+	response := ProjectTreeToResponse(tree)
+	responses.Success(c, "Project tree retrieved successfully", response)
+}
+
+func (h *ProjectHandler) MoveProject(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	var req MoveProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	project, err := h.projectService.MoveProject(uint(id), req.ParentID, req.UserID)
+	if err != nil {
+		if err.Error() == "user cannot update this project" || err.Error() == "user cannot add sub-projects to this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "project hierarchy cannot contain cycles" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		if err.Error() == "parent project not found" || err.Error() == "sub-project must belong to the parent's company" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := ProjectToResponse(project)
+	responses.Success(c, "Project moved successfully", response)
+}
+
+func (h *ProjectHandler) CloneProject(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	var req CloneProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	project, members, err := h.projectService.CloneProject(uint(id), projects.CloneOptions{
+		Title:          req.Title,
+		StartDate:      req.StartDate,
+		IncludeMembers: req.IncludeMembers,
+	}, req.UserID)
+	if err != nil {
+		if err.Error() == "user cannot access this project" || err.Error() == "user cannot create projects in this company" || err.Error() == "user cannot add sub-projects to this project" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Created(c, "Project cloned successfully", gin.H{
+		"project": ProjectToResponse(project),
+		"members": MembersToResponse(members),
+	})
+}
+
+func (h *ProjectHandler) BulkUpdateStatus(c *gin.Context) {
+	var req BulkStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.BulkUpdateStatus(req.ProjectIDs, req.Status, req.Atomic, req.UserID)
+	respondBulk(c, "Project statuses updated", result, err)
+}
+
+func (h *ProjectHandler) BulkReassignOwner(c *gin.Context) {
+	var req BulkOwnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.BulkReassignOwner(req.ProjectIDs, req.OwnerID, req.Atomic, req.UserID)
+	respondBulk(c, "Project owners reassigned", result, err)
+}
+
+func (h *ProjectHandler) BulkMoveToCompany(c *gin.Context) {
+	var req BulkCompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.BulkMoveToCompany(req.ProjectIDs, req.CompanyID, req.Atomic, req.UserID)
+	respondBulk(c, "Projects moved", result, err)
+}
+
+func (h *ProjectHandler) BulkDelete(c *gin.Context) {
+	var req BulkProjectsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.BulkDelete(req.ProjectIDs, req.Atomic, req.UserID)
+	respondBulk(c, "Projects deleted", result, err)
+}
+
+func (h *ProjectHandler) BulkAddMember(c *gin.Context) {
+	var req BulkMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+	if req.Role == "" {
+		responses.BadRequest(c, "Role required")
+		return
+	}
+
+	result, err := h.projectService.BulkAddMember(req.ProjectIDs, req.UserID, req.Role, req.Permissions, req.Atomic, req.RequestingUserID)
+	respondBulk(c, "Member added to projects", result, err)
+}
+
+func (h *ProjectHandler) BulkRemoveMember(c *gin.Context) {
+	var req BulkMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.BulkRemoveMember(req.ProjectIDs, req.UserID, req.Atomic, req.RequestingUserID)
+	respondBulk(c, "Member removed from projects", result, err)
+}
+
+func (h *ProjectHandler) ExportProjects(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	var companyID *string
+	filename := "projects"
+	if id := c.Query("companyId"); id != "" {
+		companyID = &id
+		filename = "company-" + id + "-projects"
+	}
+
+	var writer projects.RecordWriter
+	switch c.DefaultQuery("format", "json") {
+	case "csv":
+		c.Header("Content-Type", "text/csv")
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`.csv"`)
+		writer = projects.NewCSVRecordWriter(c.Writer)
+	case "json":
+		c.Header("Content-Type", "application/json")
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`.json"`)
+		writer = projects.NewJSONRecordWriter(c.Writer)
+	default:
+		responses.BadRequest(c, "Invalid format, expected csv or json")
+		return
+	}
+
+	if err := h.projectService.ExportProjects(companyID, userID, writer); err != nil {
+		// Once rows are streamed the status is sent and the error can only end the body
+		if c.Writer.Written() {
+			c.Error(err)
+			return
+		}
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		if err.Error() == "user cannot access this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+	}
+}
+
+// ImportProjects reads the request body, a CSV file or a JSON array, and
+// upserts one project per record
+func (h *ProjectHandler) ImportProjects(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	options := projects.ImportOptions{DryRun: c.Query("dryRun") == "true"}
+	if id := c.Query("companyId"); id != "" {
+		options.CompanyID = &id
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		format = "json"
+		if strings.HasPrefix(c.ContentType(), "text/csv") {
+			format = "csv"
+		}
+	}
+
+	var reader projects.RecordReader
+	var err error
+	switch format {
+	case "csv":
+		reader, err = projects.NewCSVRecordReader(c.Request.Body)
+	case "json":
+		reader, err = projects.NewJSONRecordReader(c.Request.Body)
+	default:
+		responses.BadRequest(c, "Invalid format, expected csv or json")
+		return
+	}
+	if err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.ImportProjects(reader, options, userID)
+	if err != nil {
+		if err.Error() == "user cannot create projects in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		// Anything else rejects the whole file, e.g. malformed JSON or too many rows
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	response := ImportResultToResponse(result)
+	switch {
+	case result.Failed > 0:
+		responses.SuccessWithStatus(c, http.StatusMultiStatus, "Import completed with failures", response)
+	case result.DryRun:
+		responses.Success(c, "Import validated successfully", response)
+	default:
+		responses.Success(c, "Import completed successfully", response)
+	}
+}
+
+// respondBulk reports per-item results: 200 when every item succeeded, 207 on
+// partial failure and 422 when an atomic batch was rolled back.
+func respondBulk(c *gin.Context, message string, result *projects.BulkResult, err error) {
+	if err != nil {
+		if err.Error() == "user cannot create projects in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "invalid project status" || err.Error() == "new owner ID is required" ||
+			err.Error() == "at least one project ID is required" || strings.HasPrefix(err.Error(), "bulk request exceeds maximum") {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := BulkResultToResponse(result)
+	switch {
+	case result.RolledBack:
+		responses.ErrorWithMetadata(c, http.StatusUnprocessableEntity, responses.ErrCodeUnprocessableEntity, "Bulk operation rolled back", response)
+	case result.Failed > 0:
+		responses.SuccessWithStatus(c, http.StatusMultiStatus, message+" with failures", response)
+	default:
+		responses.Success(c, message+" successfully", response)
+	}
+}
+
+// parseProjectFilter reads repeated field=key:value query parameters
+func parseProjectFilter(c *gin.Context) (projects.ProjectFilter, error) {
+	var filter projects.ProjectFilter
+	for _, field := range c.QueryArray("field") {
+		key, value, ok := strings.Cut(field, ":")
+		if !ok || key == "" {
+			return filter, errors.New("invalid field filter, expected key:value")
+		}
+		if filter.CustomFields == nil {
+			filter.CustomFields = make(map[string]string)
+		}
+		filter.CustomFields[key] = value
+	}
+	for _, tag := range c.QueryArray("tag") {
+		if strings.TrimSpace(tag) == "" {
+			return filter, errors.New("invalid tag filter, expected a tag name")
+		}
+		filter.Tags = append(filter.Tags, tag)
+	}
+	return filter, nil
+}
+
+func isCustomFieldError(err error) bool {
+	return strings.HasPrefix(err.Error(), "invalid custom field")
+}
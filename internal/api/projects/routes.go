@@ -22,11 +22,39 @@ func RegisterRoutes(router *gin.RouterGroup, projectService *projects.ProjectSer
 		internal.PUT("/:id", handler.UpdateProject)    // Update project
 		internal.DELETE("/:id", handler.DeleteProject) // Delete project
 
+		// Project cloning
+		internal.POST("/:id/clone", handler.CloneProject) // Clone project, optionally with members
+
+		// Project hierarchy
+		internal.GET("/:id/children", handler.GetProjectChildren) // Get direct sub-projects
+		internal.GET("/:id/tree", handler.GetProjectTree)         // Get sub-project tree with roll-ups
+		internal.PUT("/:id/parent", handler.MoveProject)          // Attach to or detach from a parent
+
+		// Bulk operations
+		internal.POST("/bulk/status", handler.BulkUpdateStatus)         // Change status of many projects
+		internal.POST("/bulk/owner", handler.BulkReassignOwner)         // Reassign owner of many projects
+		internal.POST("/bulk/company", handler.BulkMoveToCompany)       // Move many projects to a company
+		internal.POST("/bulk/delete", handler.BulkDelete)               // Delete many projects
+		internal.POST("/bulk/members/add", handler.BulkAddMember)       // Add one user to many projects
+		internal.POST("/bulk/members/remove", handler.BulkRemoveMember) // Remove one user from many projects
+
+		// Import and export
+		internal.GET("/export", handler.ExportProjects)  // Export projects with members (query: userId, companyId, format=csv|json)
+		internal.POST("/import", handler.ImportProjects) // Upsert projects by external key (query: userId, companyId, format, dryRun)
+
 		// User projects
 		internal.GET("", handler.GetUserProjects) // Get user's projects (query: userId)
 
 		// Project members
 		internal.GET("/:id/members", handler.GetProjectMembers) // Get project members
 		internal.POST("/:id/members", handler.AddProjectMember) // Add member to project
+
+		// Member role and permissions
+		internal.PUT("/:id/members/:userId", handler.UpdateProjectMember) // Change a member's role or permissions
+
+		// Project teams
+		internal.GET("/:id/teams", handler.GetProjectTeams)              // Get teams granted on project
+		internal.POST("/:id/teams", handler.AddProjectTeam)              // Grant a team a role on project
+		internal.DELETE("/:id/teams/:teamId", handler.RemoveProjectTeam) // Revoke a team's access
 	}
 }
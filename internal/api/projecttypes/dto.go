@@ -0,0 +1,114 @@
+package projecttypes
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projecttypes"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+)
+
+// Request DTOs
+type RegisterProjectTypeRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Service     string `json:"service" binding:"required"`
+	CallbackURL string `json:"callbackUrl"`
+}
+
+type AddMemberRequest struct {
+	UserID      string   `json:"userId" binding:"required"`
+	Role        string   `json:"role" binding:"required"`
+	Permissions []string `json:"permissions"`
+}
+
+type UpdateMemberRequest struct {
+	Role        string   `json:"role"`        // Omit to keep the current role
+	Permissions []string `json:"permissions"` // Omit to keep the current permissions
+}
+
+// Response DTOs
+type ProjectTypeResponse struct {
+	Name         string    `json:"name"`
+	Service      string    `json:"service"`
+	CallbackURL  string    `json:"callbackUrl,omitempty"`
+	RegisteredAt time.Time `json:"registeredAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+type MemberResponse struct {
+	ProjectID   string    `json:"projectId"`
+	ProjectType string    `json:"projectType"`
+	UserID      string    `json:"userId"`
+	Role        string    `json:"role"`
+	Permissions []string  `json:"permissions"`
+	JoinedAt    time.Time `json:"joinedAt"`
+}
+
+// MembershipResponse is a member with the service that owns the project
+type MembershipResponse struct {
+	MemberResponse
+	Service     string `json:"service"`
+	CallbackURL string `json:"callbackUrl,omitempty"`
+}
+
+// Use standardized list responses
+type ProjectTypeListResponse = types.ListResponse[ProjectTypeResponse]
+type MemberListResponse = types.ListResponse[MemberResponse]
+type MembershipListResponse = types.ListResponse[MembershipResponse]
+
+func (r *RegisterProjectTypeRequest) ToProjectType() *db.ProjectType {
+	return &db.ProjectType{
+		Name:        r.Name,
+		Service:     r.Service,
+		CallbackURL: r.CallbackURL,
+	}
+}
+
+func ProjectTypeToResponse(projectType *db.ProjectType) ProjectTypeResponse {
+	return ProjectTypeResponse{
+		Name:         projectType.Name,
+		Service:      projectType.Service,
+		CallbackURL:  projectType.CallbackURL,
+		RegisteredAt: projectType.RegisteredAt,
+		UpdatedAt:    projectType.UpdatedAt,
+	}
+}
+
+func ProjectTypesToResponse(projectTypes []db.ProjectType) []ProjectTypeResponse {
+	responses := make([]ProjectTypeResponse, len(projectTypes))
+	for i, projectType := range projectTypes {
+		responses[i] = ProjectTypeToResponse(&projectType)
+	}
+	return responses
+}
+
+func MemberToResponse(member *db.ProjectMember) MemberResponse {
+	return MemberResponse{
+		ProjectID:   member.ProjectID,
+		ProjectType: member.ProjectType,
+		UserID:      member.UserID,
+		Role:        member.Role,
+		Permissions: member.Permissions,
+		JoinedAt:    member.JoinedAt,
+	}
+}
+
+func MembersToResponse(members []db.ProjectMember) []MemberResponse {
+	responses := make([]MemberResponse, len(members))
+	for i, member := range members {
+		responses[i] = MemberToResponse(&member)
+	}
+	return responses
+}
+
+func MembershipsToResponse(memberships []projecttypes.Membership) []MembershipResponse {
+	responses := make([]MembershipResponse, len(memberships))
+	for i, membership := range memberships {
+		responses[i] = MembershipResponse{
+			MemberResponse: MemberToResponse(&membership.ProjectMember),
+			Service:        membership.Service,
+			CallbackURL:    membership.CallbackURL,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,227 @@
+package projecttypes
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projecttypes"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectTypeHandler struct {
+	projectTypeService *projecttypes.ProjectTypeService
+}
+
+func NewProjectTypeHandler(projectTypeService *projecttypes.ProjectTypeService) *ProjectTypeHandler {
+	return &ProjectTypeHandler{
+		projectTypeService: projectTypeService,
+	}
+}
+
+func (h *ProjectTypeHandler) RegisterProjectType(c *gin.Context) {
+	var req RegisterProjectTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	projectType, created, err := h.projectTypeService.RegisterProjectType(req.ToProjectType())
+	if err != nil {
+		if err.Error() == "project type is registered by another service" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		if isInputError(err) {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := ProjectTypeToResponse(projectType)
+	if created {
+		responses.Created(c, "Project type registered successfully", response)
+		return
+	}
+	responses.SuccessWithStatus(c, http.StatusOK, "Project type updated successfully", response)
+}
+
+func (h *ProjectTypeHandler) GetProjectTypes(c *gin.Context) {
+	projectTypes, err := h.projectTypeService.ListProjectTypes()
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	typeResponses := ProjectTypesToResponse(projectTypes)
+	response := ProjectTypeListResponse{
+		Data: typeResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(typeResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Project types retrieved successfully", response)
+}
+
+func (h *ProjectTypeHandler) GetProjectType(c *gin.Context) {
+	projectType, err := h.projectTypeService.GetProjectType(c.Param("name"))
+	if err != nil {
+		if err.Error() == "project type not found" {
+			responses.NotFound(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := ProjectTypeToResponse(projectType)
+	responses.Success(c, "Project type retrieved successfully", response)
+}
+
+func (h *ProjectTypeHandler) UnregisterProjectType(c *gin.Context) {
+	service := c.Query("service")
+	if service == "" {
+		responses.BadRequest(c, "Service required")
+		return
+	}
+
+	err := h.projectTypeService.UnregisterProjectType(c.Param("name"), service)
+	if err != nil {
+		respondMemberError(c, err)
+		return
+	}
+
+	responses.Success(c, "Project type unregistered successfully", nil)
+}
+
+// Members of a module's projects
+
+func (h *ProjectTypeHandler) GetProjectMembers(c *gin.Context) {
+	members, err := h.projectTypeService.GetProjectMembers(c.Param("name"), c.Param("projectId"))
+	if err != nil {
+		respondMemberError(c, err)
+		return
+	}
+
+	memberResponses := MembersToResponse(members)
+	response := MemberListResponse{
+		Data: memberResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(memberResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Members retrieved successfully", response)
+}
+
+func (h *ProjectTypeHandler) AddProjectMember(c *gin.Context) {
+	var req AddMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.projectTypeService.AddProjectMember(&db.ProjectMember{
+		ProjectID:   c.Param("projectId"),
+		ProjectType: c.Param("name"),
+		UserID:      req.UserID,
+		Role:        req.Role,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		respondMemberError(c, err)
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Created(c, "Member added successfully", response)
+}
+
+func (h *ProjectTypeHandler) UpdateProjectMember(c *gin.Context) {
+	var req UpdateMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.projectTypeService.UpdateProjectMember(c.Param("name"), c.Param("projectId"), c.Param("userId"), req.Role, req.Permissions)
+	if err != nil {
+		respondMemberError(c, err)
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Success(c, "Member updated successfully", response)
+}
+
+func (h *ProjectTypeHandler) RemoveProjectMember(c *gin.Context) {
+	err := h.projectTypeService.RemoveProjectMember(c.Param("name"), c.Param("projectId"), c.Param("userId"))
+	if err != nil {
+		respondMemberError(c, err)
+		return
+	}
+
+	responses.Success(c, "Member removed successfully", nil)
+}
+
+func (h *ProjectTypeHandler) GetUserMemberships(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	memberships, err := h.projectTypeService.GetUserMemberships(userID, c.Query("projectType"))
+	if err != nil {
+		if err.Error() == "project type not found" {
+			responses.NotFound(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	membershipResponses := MembershipsToResponse(memberships)
+	response := MembershipListResponse{
+		Data: membershipResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(membershipResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Memberships retrieved successfully", response)
+}
+
+// Private helper methods
+
+func respondMemberError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "project type not found", "user is not a member of this project":
+		responses.NotFound(c, err.Error())
+	case "project type is registered by another service":
+		responses.Forbidden(c, err.Error())
+	case "user is already a member of this project", "project type has members":
+		responses.Conflict(c, err.Error())
+	case "core project members are managed through the project endpoints", "project ID is required":
+		responses.BadRequest(c, err.Error())
+	default:
+		responses.InternalError(c, err.Error())
+	}
+}
+
+func isInputError(err error) bool {
+	switch err.Error() {
+	case "project type name is reserved", "invalid project type name", "service is required", "invalid callback URL":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package projecttypes
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projecttypes"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the project type registry and external membership routes
+func RegisterRoutes(router *gin.RouterGroup, projectTypeService *projecttypes.ProjectTypeService) {
+	handler := NewProjectTypeHandler(projectTypeService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/project-types")
+	{
+		// Registry
+		internal.POST("", handler.RegisterProjectType)           // Register or update a type (body: name, service, callbackUrl)
+		internal.GET("", handler.GetProjectTypes)                // List types, core first
+		internal.GET("/:name", handler.GetProjectType)           // Get type by name
+		internal.DELETE("/:name", handler.UnregisterProjectType) // Remove a type without members (query: service)
+
+		// Members of a module's projects
+		internal.GET("/:name/projects/:projectId/members", handler.GetProjectMembers)
+		internal.POST("/:name/projects/:projectId/members", handler.AddProjectMember)
+		internal.PUT("/:name/projects/:projectId/members/:userId", handler.UpdateProjectMember)
+		internal.DELETE("/:name/projects/:projectId/members/:userId", handler.RemoveProjectMember)
+	}
+
+	// A user's memberships across every project type (query: userId, projectType)
+	router.GET("/internal/memberships", handler.GetUserMemberships)
+}
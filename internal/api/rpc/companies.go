@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/JorgeSaicoski/go-project-manager/internal/api/rpc/projectcorev1"
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type companyServer struct {
+	pb.UnimplementedCompanyServiceServer
+	companyService *companies.CompanyService
+}
+
+func (s *companyServer) GetCompany(ctx context.Context, req *pb.GetCompanyRequest) (*pb.Company, error) {
+	company, err := s.companyService.GetCompany(req.Id, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return companyToProto(company), nil
+}
+
+func (s *companyServer) ListCompanies(ctx context.Context, req *pb.ListCompaniesRequest) (*pb.ListCompaniesResponse, error) {
+	userCompanies, err := s.companyService.GetUserCompanies(userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListCompaniesResponse{Companies: make([]*pb.Company, len(userCompanies))}
+	for i := range userCompanies {
+		response.Companies[i] = companyToProto(&userCompanies[i])
+	}
+	return response, nil
+}
+
+func (s *companyServer) CreateCompany(ctx context.Context, req *pb.CreateCompanyRequest) (*pb.Company, error) {
+	if req.Name == "" || req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "name and type are required")
+	}
+
+	company, err := s.companyService.CreateCompany(&db.Company{
+		ID:      req.Id,
+		Name:    req.Name,
+		Type:    req.Type,
+		OwnerID: userID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return companyToProto(company), nil
+}
+
+func (s *companyServer) ListCompanyMembers(ctx context.Context, req *pb.ListCompanyMembersRequest) (*pb.ListCompanyMembersResponse, error) {
+	members, err := s.companyService.GetCompanyMembers(req.CompanyId, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListCompanyMembersResponse{Members: make([]*pb.CompanyMember, len(members))}
+	for i := range members {
+		response.Members[i] = companyMemberToProto(&members[i])
+	}
+	return response, nil
+}
+
+func (s *companyServer) AddCompanyMember(ctx context.Context, req *pb.AddCompanyMemberRequest) (*pb.CompanyMember, error) {
+	if req.UserId == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID and role are required")
+	}
+
+	member, err := s.companyService.AddCompanyMember(req.CompanyId, req.UserId, req.Role, nil, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return companyMemberToProto(member), nil
+}
+
+func (s *companyServer) UpdateCompanyMemberRole(ctx context.Context, req *pb.UpdateCompanyMemberRoleRequest) (*pb.CompanyMember, error) {
+	member, err := s.companyService.UpdateCompanyMemberRole(req.CompanyId, req.UserId, req.Role, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return companyMemberToProto(member), nil
+}
+
+func (s *companyServer) RemoveCompanyMember(ctx context.Context, req *pb.RemoveCompanyMemberRequest) (*pb.RemoveCompanyMemberResponse, error) {
+	if err := s.companyService.RemoveCompanyMember(req.CompanyId, req.UserId, userID(ctx)); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveCompanyMemberResponse{}, nil
+}
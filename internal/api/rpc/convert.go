@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"time"
+
+	pb "github.com/JorgeSaicoski/go-project-manager/internal/api/rpc/projectcorev1"
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func projectToProto(project *db.BaseProject) *pb.Project {
+	response := &pb.Project{
+		Id:          uint32(project.ID),
+		Title:       project.Title,
+		Description: project.Description,
+		Status:      project.Status,
+		OwnerId:     project.OwnerID,
+		CompanyId:   project.CompanyID,
+		StartDate:   timestamp(project.StartDate),
+		EndDate:     timestamp(project.EndDate),
+		CompletedAt: timestamp(project.CompletedAt),
+		ExternalKey: project.ExternalKey,
+		CreatedAt:   timestamppb.New(project.CreatedAt),
+		UpdatedAt:   timestamppb.New(project.UpdatedAt),
+	}
+	if project.ParentID != nil {
+		parentID := uint32(*project.ParentID)
+		response.ParentId = &parentID
+	}
+	return response
+}
+
+func projectMemberToProto(member *db.ProjectMember) *pb.ProjectMember {
+	return &pb.ProjectMember{
+		ProjectId:   member.ProjectID,
+		ProjectType: member.ProjectType,
+		UserId:      member.UserID,
+		Role:        member.Role,
+		Permissions: member.Permissions,
+		JoinedAt:    timestamppb.New(member.JoinedAt),
+	}
+}
+
+func companyToProto(company *db.Company) *pb.Company {
+	return &pb.Company{
+		Id:      company.ID,
+		Name:    company.Name,
+		Type:    company.Type,
+		OwnerId: company.OwnerID,
+	}
+}
+
+// companyMemberToProto leaves out compensation
+func companyMemberToProto(member *db.CompanyMember) *pb.CompanyMember {
+	return &pb.CompanyMember{
+		Id:        uint32(member.ID),
+		CompanyId: member.CompanyID,
+		UserId:    member.UserID,
+		Role:      member.Role,
+		Status:    member.Status,
+		JoinedAt:  timestamp(member.JoinedAt),
+		InvitedAt: timestamppb.New(member.InvitedAt),
+		InvitedBy: member.InvitedBy,
+	}
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.AsTime()
+	return &value
+}
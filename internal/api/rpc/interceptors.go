@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// UserIDKey is the metadata key that identifies the calling user, like the
+// X-User-ID header of the REST API
+const UserIDKey = "x-user-id"
+
+type userIDContextKey struct{}
+
+// Service errors by status code. Errors not listed are internal.
+var errorCodes = map[string]codes.Code{
+	"parent project not found":             codes.NotFound,
+	"invitation not found":                 codes.NotFound,
+	"user is not a member of this project": codes.NotFound,
+	"user is not a member of this company": codes.NotFound,
+
+	"user cannot access this project":                  codes.PermissionDenied,
+	"user cannot update this project":                  codes.PermissionDenied,
+	"user cannot add members to this project":          codes.PermissionDenied,
+	"user cannot update members of this project":       codes.PermissionDenied,
+	"user cannot add sub-projects to this project":     codes.PermissionDenied,
+	"user cannot create projects in this company":      codes.PermissionDenied,
+	"only project owner can delete project":            codes.PermissionDenied,
+	"user cannot access this company":                  codes.PermissionDenied,
+	"user cannot add members to this company":          codes.PermissionDenied,
+	"user cannot update members of this company":       codes.PermissionDenied,
+	"user cannot remove members from this company":     codes.PermissionDenied,
+	"only owners and admins can grant or revoke admin": codes.PermissionDenied,
+	"cannot change your own role":                      codes.PermissionDenied,
+
+	"user is already a member of this project": codes.AlreadyExists,
+	"user is already a member of this company": codes.AlreadyExists,
+
+	"project has sub-projects":         codes.FailedPrecondition,
+	"cannot remove company owner":      codes.FailedPrecondition,
+	"cannot change company owner role": codes.FailedPrecondition,
+
+	"company ID is required": codes.InvalidArgument,
+	"invalid role":           codes.InvalidArgument,
+	"sub-project must belong to the parent's company": codes.InvalidArgument,
+}
+
+// userID returns the calling user set by withIdentity
+func userID(ctx context.Context) string {
+	id, _ := ctx.Value(userIDContextKey{}).(string)
+	return id
+}
+
+// withIdentity requires the calling user in the request metadata. Health
+// checks come from the infrastructure and need none.
+func withIdentity(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if strings.HasPrefix(info.FullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(UserIDKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "user ID required in "+UserIDKey+" metadata")
+	}
+	return handler(context.WithValue(ctx, userIDContextKey{}, values[0]), req)
+}
+
+// withDeadline gives calls without a deadline the default one. The services
+// do not take a context, so a call is refused once its deadline has passed,
+// and one that overran it reports DeadlineExceeded, but database work that
+// already started runs to completion.
+func withDeadline(defaultTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := ctx.Deadline(); !ok && defaultTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+			defer cancel()
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		resp, err := handler(ctx, req)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		return resp, err
+	}
+}
+
+// withStatus turns service errors into status errors
+func withStatus(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return nil, err
+	}
+
+	if code, ok := errorCodes[err.Error()]; ok {
+		return nil, status.Error(code, err.Error())
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+		return nil, status.Error(codes.AlreadyExists, "already exists")
+	}
+
+	log.Error("call:failed", "method", info.FullMethod, "error", err)
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// recoverPanics keeps a failing call from taking the server down
+func recoverPanics(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("call:panic", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/JorgeSaicoski/go-project-manager/internal/api/rpc/projectcorev1"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type permissionServer struct {
+	pb.UnimplementedPermissionServiceServer
+	projectService *projects.ProjectService
+	companyService *companies.CompanyService
+}
+
+func (s *permissionServer) CheckProjectPermission(ctx context.Context, req *pb.CheckProjectPermissionRequest) (*pb.CheckPermissionResponse, error) {
+	var check func(userID string, projectID uint) (bool, error)
+	switch req.Permission {
+	case pb.Permission_PERMISSION_VIEW:
+		check = s.projectService.CanAccessProject
+	case pb.Permission_PERMISSION_UPDATE:
+		check = s.projectService.CanUpdateProject
+	case pb.Permission_PERMISSION_MANAGE_MEMBERS:
+		check = s.projectService.CanManageProjectMembers
+	default:
+		return nil, status.Error(codes.InvalidArgument, "permission is required")
+	}
+
+	allowed, err := check(userID(ctx), uint(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckPermissionResponse{Allowed: allowed}, nil
+}
+
+func (s *permissionServer) CheckCompanyPermission(ctx context.Context, req *pb.CheckCompanyPermissionRequest) (*pb.CheckPermissionResponse, error) {
+	var check func(userID, companyID string) (bool, error)
+	switch req.Permission {
+	case pb.Permission_PERMISSION_VIEW:
+		check = s.companyService.CanAccessCompany
+	case pb.Permission_PERMISSION_UPDATE:
+		check = s.companyService.CanUpdateCompany
+	case pb.Permission_PERMISSION_MANAGE_MEMBERS:
+		check = s.companyService.CanManageCompanyMembers
+	default:
+		return nil, status.Error(codes.InvalidArgument, "permission is required")
+	}
+
+	allowed, err := check(userID(ctx), req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckPermissionResponse{Allowed: allowed}, nil
+}
@@ -0,0 +1,2030 @@
+// Project-Core gRPC API for the specialized modules. It serves the same
+// operations as the /api/internal REST routes.
+//
+// Every call identifies the acting user with the x-user-id metadata key.
+// Errors use standard status codes: NOT_FOUND, PERMISSION_DENIED,
+// ALREADY_EXISTS, INVALID_ARGUMENT, FAILED_PRECONDITION, UNAUTHENTICATED,
+// DEADLINE_EXCEEDED and INTERNAL.
+//
+// Regenerate the Go code with `go generate ./internal/api/rpc/...`.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: projectcore/v1/project_core.proto
+
+package projectcorev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED    Permission = 0
+	Permission_PERMISSION_VIEW           Permission = 1
+	Permission_PERMISSION_UPDATE         Permission = 2
+	Permission_PERMISSION_MANAGE_MEMBERS Permission = 3
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "PERMISSION_VIEW",
+		2: "PERMISSION_UPDATE",
+		3: "PERMISSION_MANAGE_MEMBERS",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":    0,
+		"PERMISSION_VIEW":           1,
+		"PERMISSION_UPDATE":         2,
+		"PERMISSION_MANAGE_MEMBERS": 3,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_projectcore_v1_project_core_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_projectcore_v1_project_core_proto_enumTypes[0]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{0}
+}
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active, completed, paused, cancelled
+	OwnerId       string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CompanyId     *string                `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	ParentId      *uint32                `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExternalKey   *string                `protobuf:"bytes,11,opt,name=external_key,json=externalKey,proto3,oneof" json:"external_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Project) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Project) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Project) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *Project) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *Project) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Project) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Project) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Project) GetExternalKey() string {
+	if x != nil && x.ExternalKey != nil {
+		return *x.ExternalKey
+	}
+	return ""
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ProjectMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectType   string                 `protobuf:"bytes,2,opt,name=project_type,json=projectType,proto3" json:"project_type,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProjectMember) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetProjectType() string {
+	if x != nil {
+		return x.ProjectType
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ProjectMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProjectRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{3}
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // Defaults to active
+	CompanyId     *string                `protobuf:"bytes,4,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	ParentId      *uint32                `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateProjectRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateProjectRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// UpdateProjectRequest changes the fields that are set
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status        *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateProjectRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProjectRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteProjectRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{8}
+}
+
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint32                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersRequest) ProtoMessage() {}
+
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListProjectMembersRequest) GetProjectId() uint32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type ListProjectMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ProjectMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersResponse) Reset() {
+	*x = ListProjectMembersResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersResponse) ProtoMessage() {}
+
+func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProjectMembersResponse) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint32                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberRequest) Reset() {
+	*x = AddProjectMemberRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberRequest) ProtoMessage() {}
+
+func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddProjectMemberRequest) GetProjectId() uint32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AddProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UpdateProjectMemberRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId          uint32                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role               string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // Empty keeps the current role
+	Permissions        []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ReplacePermissions bool                   `protobuf:"varint,5,opt,name=replace_permissions,json=replacePermissions,proto3" json:"replace_permissions,omitempty"` // Set permissions even when the list is empty
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateProjectMemberRequest) Reset() {
+	*x = UpdateProjectMemberRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectMemberRequest) ProtoMessage() {}
+
+func (x *UpdateProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateProjectMemberRequest) GetProjectId() uint32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *UpdateProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProjectMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateProjectMemberRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *UpdateProjectMemberRequest) GetReplacePermissions() bool {
+	if x != nil {
+		return x.ReplacePermissions
+	}
+	return false
+}
+
+type Company struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // enterprise, school, personal
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Company) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Company) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// CompanyMember leaves out compensation, which stays on the REST API
+type CompanyMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // active, invited, suspended
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	InvitedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,8,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyMember) Reset() {
+	*x = CompanyMember{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyMember) ProtoMessage() {}
+
+func (x *CompanyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyMember.ProtoReflect.Descriptor instead.
+func (*CompanyMember) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompanyMember) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompanyMember) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *CompanyMember) GetInvitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return nil
+}
+
+func (x *CompanyMember) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{16}
+}
+
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListCompanyMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompanyMembersRequest) Reset() {
+	*x = ListCompanyMembersRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanyMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyMembersRequest) ProtoMessage() {}
+
+func (x *ListCompanyMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListCompanyMembersRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCompanyMembersRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type ListCompanyMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*CompanyMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompanyMembersResponse) Reset() {
+	*x = ListCompanyMembersResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanyMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyMembersResponse) ProtoMessage() {}
+
+func (x *ListCompanyMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListCompanyMembersResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCompanyMembersResponse) GetMembers() []*CompanyMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddCompanyMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCompanyMemberRequest) Reset() {
+	*x = AddCompanyMemberRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCompanyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCompanyMemberRequest) ProtoMessage() {}
+
+func (x *AddCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddCompanyMemberRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *AddCompanyMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddCompanyMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateCompanyMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyMemberRoleRequest) Reset() {
+	*x = UpdateCompanyMemberRoleRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCompanyMemberRoleRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *UpdateCompanyMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCompanyMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RemoveCompanyMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCompanyMemberRequest) Reset() {
+	*x = RemoveCompanyMemberRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCompanyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCompanyMemberRequest) ProtoMessage() {}
+
+func (x *RemoveCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveCompanyMemberRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RemoveCompanyMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveCompanyMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCompanyMemberResponse) Reset() {
+	*x = RemoveCompanyMemberResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCompanyMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCompanyMemberResponse) ProtoMessage() {}
+
+func (x *RemoveCompanyMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCompanyMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyMemberResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{24}
+}
+
+type CheckProjectPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint32                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Permission    Permission             `protobuf:"varint,2,opt,name=permission,proto3,enum=projectcore.v1.Permission" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckProjectPermissionRequest) Reset() {
+	*x = CheckProjectPermissionRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckProjectPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckProjectPermissionRequest) ProtoMessage() {}
+
+func (x *CheckProjectPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckProjectPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckProjectPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckProjectPermissionRequest) GetProjectId() uint32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *CheckProjectPermissionRequest) GetPermission() Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+type CheckCompanyPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Permission    Permission             `protobuf:"varint,2,opt,name=permission,proto3,enum=projectcore.v1.Permission" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCompanyPermissionRequest) Reset() {
+	*x = CheckCompanyPermissionRequest{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCompanyPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCompanyPermissionRequest) ProtoMessage() {}
+
+func (x *CheckCompanyPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCompanyPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckCompanyPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CheckCompanyPermissionRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CheckCompanyPermissionRequest) GetPermission() Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projectcore_v1_project_core_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_projectcore_v1_project_core_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+var File_projectcore_v1_project_core_proto protoreflect.FileDescriptor
+
+const file_projectcore_v1_project_core_proto_rawDesc = "" +
+	"\n" +
+	"!projectcore/v1/project_core.proto\x12\x0eprojectcore.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\x04\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12\"\n" +
+	"\n" +
+	"company_id\x18\x06 \x01(\tH\x01R\tcompanyId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\a \x01(\rH\x02R\bparentId\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12&\n" +
+	"\fexternal_key\x18\v \x01(\tH\x03R\vexternalKey\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_company_idB\f\n" +
+	"\n" +
+	"_parent_idB\x0f\n" +
+	"\r_external_key\"\xd9\x01\n" +
+	"\rProjectMember\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_type\x18\x02 \x01(\tR\vprojectType\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x127\n" +
+	"\tjoined_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"#\n" +
+	"\x11GetProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x15\n" +
+	"\x13ListProjectsRequest\"K\n" +
+	"\x14ListProjectsResponse\x123\n" +
+	"\bprojects\x18\x01 \x03(\v2\x17.projectcore.v1.ProjectR\bprojects\"\xd0\x02\n" +
+	"\x14CreateProjectRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\"\n" +
+	"\n" +
+	"company_id\x18\x04 \x01(\tH\x01R\tcompanyId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x05 \x01(\rH\x02R\bparentId\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDateB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_company_idB\f\n" +
+	"\n" +
+	"_parent_id\"\x9c\x02\n" +
+	"\x14UpdateProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x02R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDateB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_status\"&\n" +
+	"\x14DeleteProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x17\n" +
+	"\x15DeleteProjectResponse\":\n" +
+	"\x19ListProjectMembersRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\rR\tprojectId\"U\n" +
+	"\x1aListProjectMembersResponse\x127\n" +
+	"\amembers\x18\x01 \x03(\v2\x1d.projectcore.v1.ProjectMemberR\amembers\"\x87\x01\n" +
+	"\x17AddProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\rR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"\xbb\x01\n" +
+	"\x1aUpdateProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\rR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12/\n" +
+	"\x13replace_permissions\x18\x05 \x01(\bR\x12replacePermissions\"\\\n" +
+	"\aCompany\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\"\x96\x02\n" +
+	"\rCompanyMember\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x127\n" +
+	"\tjoined_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x129\n" +
+	"\n" +
+	"invited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tinvitedAt\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\b \x01(\tR\tinvitedBy\"#\n" +
+	"\x11GetCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14ListCompaniesRequest\"N\n" +
+	"\x15ListCompaniesResponse\x125\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x17.projectcore.v1.CompanyR\tcompanies\"N\n" +
+	"\x14CreateCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\":\n" +
+	"\x19ListCompanyMembersRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"U\n" +
+	"\x1aListCompanyMembersResponse\x127\n" +
+	"\amembers\x18\x01 \x03(\v2\x1d.projectcore.v1.CompanyMemberR\amembers\"e\n" +
+	"\x17AddCompanyMemberRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"l\n" +
+	"\x1eUpdateCompanyMemberRoleRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"T\n" +
+	"\x1aRemoveCompanyMemberRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1d\n" +
+	"\x1bRemoveCompanyMemberResponse\"z\n" +
+	"\x1dCheckProjectPermissionRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\rR\tprojectId\x12:\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\x0e2\x1a.projectcore.v1.PermissionR\n" +
+	"permission\"z\n" +
+	"\x1dCheckCompanyPermissionRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12:\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\x0e2\x1a.projectcore.v1.PermissionR\n" +
+	"permission\"3\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed*s\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPERMISSION_VIEW\x10\x01\x12\x15\n" +
+	"\x11PERMISSION_UPDATE\x10\x02\x12\x1d\n" +
+	"\x19PERMISSION_MANAGE_MEMBERS\x10\x032\xde\x05\n" +
+	"\x0eProjectService\x12H\n" +
+	"\n" +
+	"GetProject\x12!.projectcore.v1.GetProjectRequest\x1a\x17.projectcore.v1.Project\x12Y\n" +
+	"\fListProjects\x12#.projectcore.v1.ListProjectsRequest\x1a$.projectcore.v1.ListProjectsResponse\x12N\n" +
+	"\rCreateProject\x12$.projectcore.v1.CreateProjectRequest\x1a\x17.projectcore.v1.Project\x12N\n" +
+	"\rUpdateProject\x12$.projectcore.v1.UpdateProjectRequest\x1a\x17.projectcore.v1.Project\x12\\\n" +
+	"\rDeleteProject\x12$.projectcore.v1.DeleteProjectRequest\x1a%.projectcore.v1.DeleteProjectResponse\x12k\n" +
+	"\x12ListProjectMembers\x12).projectcore.v1.ListProjectMembersRequest\x1a*.projectcore.v1.ListProjectMembersResponse\x12Z\n" +
+	"\x10AddProjectMember\x12'.projectcore.v1.AddProjectMemberRequest\x1a\x1d.projectcore.v1.ProjectMember\x12`\n" +
+	"\x13UpdateProjectMember\x12*.projectcore.v1.UpdateProjectMemberRequest\x1a\x1d.projectcore.v1.ProjectMember2\xab\x05\n" +
+	"\x0eCompanyService\x12H\n" +
+	"\n" +
+	"GetCompany\x12!.projectcore.v1.GetCompanyRequest\x1a\x17.projectcore.v1.Company\x12\\\n" +
+	"\rListCompanies\x12$.projectcore.v1.ListCompaniesRequest\x1a%.projectcore.v1.ListCompaniesResponse\x12N\n" +
+	"\rCreateCompany\x12$.projectcore.v1.CreateCompanyRequest\x1a\x17.projectcore.v1.Company\x12k\n" +
+	"\x12ListCompanyMembers\x12).projectcore.v1.ListCompanyMembersRequest\x1a*.projectcore.v1.ListCompanyMembersResponse\x12Z\n" +
+	"\x10AddCompanyMember\x12'.projectcore.v1.AddCompanyMemberRequest\x1a\x1d.projectcore.v1.CompanyMember\x12h\n" +
+	"\x17UpdateCompanyMemberRole\x12..projectcore.v1.UpdateCompanyMemberRoleRequest\x1a\x1d.projectcore.v1.CompanyMember\x12n\n" +
+	"\x13RemoveCompanyMember\x12*.projectcore.v1.RemoveCompanyMemberRequest\x1a+.projectcore.v1.RemoveCompanyMemberResponse2\xf7\x01\n" +
+	"\x11PermissionService\x12p\n" +
+	"\x16CheckProjectPermission\x12-.projectcore.v1.CheckProjectPermissionRequest\x1a'.projectcore.v1.CheckPermissionResponse\x12p\n" +
+	"\x16CheckCompanyPermission\x12-.projectcore.v1.CheckCompanyPermissionRequest\x1a'.projectcore.v1.CheckPermissionResponseBZZXgithub.com/JorgeSaicoski/go-project-manager/internal/api/rpc/projectcorev1;projectcorev1b\x06proto3"
+
+var (
+	file_projectcore_v1_project_core_proto_rawDescOnce sync.Once
+	file_projectcore_v1_project_core_proto_rawDescData []byte
+)
+
+func file_projectcore_v1_project_core_proto_rawDescGZIP() []byte {
+	file_projectcore_v1_project_core_proto_rawDescOnce.Do(func() {
+		file_projectcore_v1_project_core_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_projectcore_v1_project_core_proto_rawDesc), len(file_projectcore_v1_project_core_proto_rawDesc)))
+	})
+	return file_projectcore_v1_project_core_proto_rawDescData
+}
+
+var file_projectcore_v1_project_core_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_projectcore_v1_project_core_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_projectcore_v1_project_core_proto_goTypes = []any{
+	(Permission)(0),                        // 0: projectcore.v1.Permission
+	(*Project)(nil),                        // 1: projectcore.v1.Project
+	(*ProjectMember)(nil),                  // 2: projectcore.v1.ProjectMember
+	(*GetProjectRequest)(nil),              // 3: projectcore.v1.GetProjectRequest
+	(*ListProjectsRequest)(nil),            // 4: projectcore.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),           // 5: projectcore.v1.ListProjectsResponse
+	(*CreateProjectRequest)(nil),           // 6: projectcore.v1.CreateProjectRequest
+	(*UpdateProjectRequest)(nil),           // 7: projectcore.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),           // 8: projectcore.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),          // 9: projectcore.v1.DeleteProjectResponse
+	(*ListProjectMembersRequest)(nil),      // 10: projectcore.v1.ListProjectMembersRequest
+	(*ListProjectMembersResponse)(nil),     // 11: projectcore.v1.ListProjectMembersResponse
+	(*AddProjectMemberRequest)(nil),        // 12: projectcore.v1.AddProjectMemberRequest
+	(*UpdateProjectMemberRequest)(nil),     // 13: projectcore.v1.UpdateProjectMemberRequest
+	(*Company)(nil),                        // 14: projectcore.v1.Company
+	(*CompanyMember)(nil),                  // 15: projectcore.v1.CompanyMember
+	(*GetCompanyRequest)(nil),              // 16: projectcore.v1.GetCompanyRequest
+	(*ListCompaniesRequest)(nil),           // 17: projectcore.v1.ListCompaniesRequest
+	(*ListCompaniesResponse)(nil),          // 18: projectcore.v1.ListCompaniesResponse
+	(*CreateCompanyRequest)(nil),           // 19: projectcore.v1.CreateCompanyRequest
+	(*ListCompanyMembersRequest)(nil),      // 20: projectcore.v1.ListCompanyMembersRequest
+	(*ListCompanyMembersResponse)(nil),     // 21: projectcore.v1.ListCompanyMembersResponse
+	(*AddCompanyMemberRequest)(nil),        // 22: projectcore.v1.AddCompanyMemberRequest
+	(*UpdateCompanyMemberRoleRequest)(nil), // 23: projectcore.v1.UpdateCompanyMemberRoleRequest
+	(*RemoveCompanyMemberRequest)(nil),     // 24: projectcore.v1.RemoveCompanyMemberRequest
+	(*RemoveCompanyMemberResponse)(nil),    // 25: projectcore.v1.RemoveCompanyMemberResponse
+	(*CheckProjectPermissionRequest)(nil),  // 26: projectcore.v1.CheckProjectPermissionRequest
+	(*CheckCompanyPermissionRequest)(nil),  // 27: projectcore.v1.CheckCompanyPermissionRequest
+	(*CheckPermissionResponse)(nil),        // 28: projectcore.v1.CheckPermissionResponse
+	(*timestamppb.Timestamp)(nil),          // 29: google.protobuf.Timestamp
+}
+var file_projectcore_v1_project_core_proto_depIdxs = []int32{
+	29, // 0: projectcore.v1.Project.start_date:type_name -> google.protobuf.Timestamp
+	29, // 1: projectcore.v1.Project.end_date:type_name -> google.protobuf.Timestamp
+	29, // 2: projectcore.v1.Project.completed_at:type_name -> google.protobuf.Timestamp
+	29, // 3: projectcore.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	29, // 4: projectcore.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 5: projectcore.v1.ProjectMember.joined_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: projectcore.v1.ListProjectsResponse.projects:type_name -> projectcore.v1.Project
+	29, // 7: projectcore.v1.CreateProjectRequest.start_date:type_name -> google.protobuf.Timestamp
+	29, // 8: projectcore.v1.CreateProjectRequest.end_date:type_name -> google.protobuf.Timestamp
+	29, // 9: projectcore.v1.UpdateProjectRequest.start_date:type_name -> google.protobuf.Timestamp
+	29, // 10: projectcore.v1.UpdateProjectRequest.end_date:type_name -> google.protobuf.Timestamp
+	2,  // 11: projectcore.v1.ListProjectMembersResponse.members:type_name -> projectcore.v1.ProjectMember
+	29, // 12: projectcore.v1.CompanyMember.joined_at:type_name -> google.protobuf.Timestamp
+	29, // 13: projectcore.v1.CompanyMember.invited_at:type_name -> google.protobuf.Timestamp
+	14, // 14: projectcore.v1.ListCompaniesResponse.companies:type_name -> projectcore.v1.Company
+	15, // 15: projectcore.v1.ListCompanyMembersResponse.members:type_name -> projectcore.v1.CompanyMember
+	0,  // 16: projectcore.v1.CheckProjectPermissionRequest.permission:type_name -> projectcore.v1.Permission
+	0,  // 17: projectcore.v1.CheckCompanyPermissionRequest.permission:type_name -> projectcore.v1.Permission
+	3,  // 18: projectcore.v1.ProjectService.GetProject:input_type -> projectcore.v1.GetProjectRequest
+	4,  // 19: projectcore.v1.ProjectService.ListProjects:input_type -> projectcore.v1.ListProjectsRequest
+	6,  // 20: projectcore.v1.ProjectService.CreateProject:input_type -> projectcore.v1.CreateProjectRequest
+	7,  // 21: projectcore.v1.ProjectService.UpdateProject:input_type -> projectcore.v1.UpdateProjectRequest
+	8,  // 22: projectcore.v1.ProjectService.DeleteProject:input_type -> projectcore.v1.DeleteProjectRequest
+	10, // 23: projectcore.v1.ProjectService.ListProjectMembers:input_type -> projectcore.v1.ListProjectMembersRequest
+	12, // 24: projectcore.v1.ProjectService.AddProjectMember:input_type -> projectcore.v1.AddProjectMemberRequest
+	13, // 25: projectcore.v1.ProjectService.UpdateProjectMember:input_type -> projectcore.v1.UpdateProjectMemberRequest
+	16, // 26: projectcore.v1.CompanyService.GetCompany:input_type -> projectcore.v1.GetCompanyRequest
+	17, // 27: projectcore.v1.CompanyService.ListCompanies:input_type -> projectcore.v1.ListCompaniesRequest
+	19, // 28: projectcore.v1.CompanyService.CreateCompany:input_type -> projectcore.v1.CreateCompanyRequest
+	20, // 29: projectcore.v1.CompanyService.ListCompanyMembers:input_type -> projectcore.v1.ListCompanyMembersRequest
+	22, // 30: projectcore.v1.CompanyService.AddCompanyMember:input_type -> projectcore.v1.AddCompanyMemberRequest
+	23, // 31: projectcore.v1.CompanyService.UpdateCompanyMemberRole:input_type -> projectcore.v1.UpdateCompanyMemberRoleRequest
+	24, // 32: projectcore.v1.CompanyService.RemoveCompanyMember:input_type -> projectcore.v1.RemoveCompanyMemberRequest
+	26, // 33: projectcore.v1.PermissionService.CheckProjectPermission:input_type -> projectcore.v1.CheckProjectPermissionRequest
+	27, // 34: projectcore.v1.PermissionService.CheckCompanyPermission:input_type -> projectcore.v1.CheckCompanyPermissionRequest
+	1,  // 35: projectcore.v1.ProjectService.GetProject:output_type -> projectcore.v1.Project
+	5,  // 36: projectcore.v1.ProjectService.ListProjects:output_type -> projectcore.v1.ListProjectsResponse
+	1,  // 37: projectcore.v1.ProjectService.CreateProject:output_type -> projectcore.v1.Project
+	1,  // 38: projectcore.v1.ProjectService.UpdateProject:output_type -> projectcore.v1.Project
+	9,  // 39: projectcore.v1.ProjectService.DeleteProject:output_type -> projectcore.v1.DeleteProjectResponse
+	11, // 40: projectcore.v1.ProjectService.ListProjectMembers:output_type -> projectcore.v1.ListProjectMembersResponse
+	2,  // 41: projectcore.v1.ProjectService.AddProjectMember:output_type -> projectcore.v1.ProjectMember
+	2,  // 42: projectcore.v1.ProjectService.UpdateProjectMember:output_type -> projectcore.v1.ProjectMember
+	14, // 43: projectcore.v1.CompanyService.GetCompany:output_type -> projectcore.v1.Company
+	18, // 44: projectcore.v1.CompanyService.ListCompanies:output_type -> projectcore.v1.ListCompaniesResponse
+	14, // 45: projectcore.v1.CompanyService.CreateCompany:output_type -> projectcore.v1.Company
+	21, // 46: projectcore.v1.CompanyService.ListCompanyMembers:output_type -> projectcore.v1.ListCompanyMembersResponse
+	15, // 47: projectcore.v1.CompanyService.AddCompanyMember:output_type -> projectcore.v1.CompanyMember
+	15, // 48: projectcore.v1.CompanyService.UpdateCompanyMemberRole:output_type -> projectcore.v1.CompanyMember
+	25, // 49: projectcore.v1.CompanyService.RemoveCompanyMember:output_type -> projectcore.v1.RemoveCompanyMemberResponse
+	28, // 50: projectcore.v1.PermissionService.CheckProjectPermission:output_type -> projectcore.v1.CheckPermissionResponse
+	28, // 51: projectcore.v1.PermissionService.CheckCompanyPermission:output_type -> projectcore.v1.CheckPermissionResponse
+	35, // [35:52] is the sub-list for method output_type
+	18, // [18:35] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_projectcore_v1_project_core_proto_init() }
+func file_projectcore_v1_project_core_proto_init() {
+	if File_projectcore_v1_project_core_proto != nil {
+		return
+	}
+	file_projectcore_v1_project_core_proto_msgTypes[0].OneofWrappers = []any{}
+	file_projectcore_v1_project_core_proto_msgTypes[5].OneofWrappers = []any{}
+	file_projectcore_v1_project_core_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_projectcore_v1_project_core_proto_rawDesc), len(file_projectcore_v1_project_core_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_projectcore_v1_project_core_proto_goTypes,
+		DependencyIndexes: file_projectcore_v1_project_core_proto_depIdxs,
+		EnumInfos:         file_projectcore_v1_project_core_proto_enumTypes,
+		MessageInfos:      file_projectcore_v1_project_core_proto_msgTypes,
+	}.Build()
+	File_projectcore_v1_project_core_proto = out.File
+	file_projectcore_v1_project_core_proto_goTypes = nil
+	file_projectcore_v1_project_core_proto_depIdxs = nil
+}
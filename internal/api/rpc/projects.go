@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/JorgeSaicoski/go-project-manager/internal/api/rpc/projectcorev1"
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var validProjectStatuses = map[string]bool{
+	"active":    true,
+	"completed": true,
+	"paused":    true,
+	"cancelled": true,
+}
+
+type projectServer struct {
+	pb.UnimplementedProjectServiceServer
+	projectService *projects.ProjectService
+}
+
+func (s *projectServer) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.Project, error) {
+	project, err := s.projectService.GetProject(uint(req.Id), userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return projectToProto(project), nil
+}
+
+func (s *projectServer) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	userProjects, err := s.projectService.GetUserProjects(userID(ctx), projects.ProjectFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListProjectsResponse{Projects: make([]*pb.Project, len(userProjects))}
+	for i := range userProjects {
+		response.Projects[i] = projectToProto(&userProjects[i])
+	}
+	return response, nil
+}
+
+func (s *projectServer) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.Project, error) {
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.Status != "" && !validProjectStatuses[req.Status] {
+		return nil, status.Error(codes.InvalidArgument, "invalid project status")
+	}
+
+	project := &db.BaseProject{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		OwnerID:     userID(ctx),
+		CompanyID:   req.CompanyId,
+		StartDate:   timeFromProto(req.StartDate),
+		EndDate:     timeFromProto(req.EndDate),
+	}
+	if req.ParentId != nil {
+		parentID := uint(*req.ParentId)
+		project.ParentID = &parentID
+	}
+
+	project, err := s.projectService.CreateProject(project)
+	if err != nil {
+		return nil, err
+	}
+	return projectToProto(project), nil
+}
+
+func (s *projectServer) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.Project, error) {
+	updates := &db.BaseProject{
+		Description: req.Description,
+		StartDate:   timeFromProto(req.StartDate),
+		EndDate:     timeFromProto(req.EndDate),
+	}
+	if req.Title != nil {
+		if *req.Title == "" {
+			return nil, status.Error(codes.InvalidArgument, "title cannot be empty")
+		}
+		updates.Title = *req.Title
+	}
+	if req.Status != nil {
+		if !validProjectStatuses[*req.Status] {
+			return nil, status.Error(codes.InvalidArgument, "invalid project status")
+		}
+		updates.Status = *req.Status
+	}
+
+	project, err := s.projectService.UpdateProject(uint(req.Id), updates, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return projectToProto(project), nil
+}
+
+func (s *projectServer) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	if err := s.projectService.DeleteProject(uint(req.Id), userID(ctx)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteProjectResponse{}, nil
+}
+
+func (s *projectServer) ListProjectMembers(ctx context.Context, req *pb.ListProjectMembersRequest) (*pb.ListProjectMembersResponse, error) {
+	members, err := s.projectService.GetProjectMembers(uint(req.ProjectId), userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListProjectMembersResponse{Members: make([]*pb.ProjectMember, len(members))}
+	for i := range members {
+		response.Members[i] = projectMemberToProto(&members[i])
+	}
+	return response, nil
+}
+
+func (s *projectServer) AddProjectMember(ctx context.Context, req *pb.AddProjectMemberRequest) (*pb.ProjectMember, error) {
+	if req.UserId == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID and role are required")
+	}
+
+	member, err := s.projectService.AddProjectMember(uint(req.ProjectId), req.UserId, req.Role, req.Permissions, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return projectMemberToProto(member), nil
+}
+
+func (s *projectServer) UpdateProjectMember(ctx context.Context, req *pb.UpdateProjectMemberRequest) (*pb.ProjectMember, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	// Repeated fields cannot tell an empty list from an unset one
+	permissions := req.Permissions
+	if len(permissions) == 0 {
+		permissions = nil
+		if req.ReplacePermissions {
+			permissions = []string{}
+		}
+	}
+
+	member, err := s.projectService.UpdateProjectMember(uint(req.ProjectId), req.UserId, req.Role, permissions, userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return projectMemberToProto(member), nil
+}
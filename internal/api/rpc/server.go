@@ -0,0 +1,64 @@
+// Package rpc serves the gRPC API defined in proto/projectcore/v1. It shares
+// the services behind the REST API, so both enforce the same rules.
+package rpc
+
+//go:generate protoc --proto_path=../../../proto --go_out=../../.. --go_opt=module=github.com/JorgeSaicoski/go-project-manager --go-grpc_out=../../.. --go-grpc_opt=module=github.com/JorgeSaicoski/go-project-manager projectcore/v1/project_core.proto
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"time"
+
+	pb "github.com/JorgeSaicoski/go-project-manager/internal/api/rpc/projectcorev1"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "api"),
+	slog.String("api", "gRPC"),
+)
+
+// DefaultTimeout bounds calls whose client set no deadline
+const DefaultTimeout = 30 * time.Second
+
+// NewServer returns a gRPC server with the project, company and permission
+// services and the standard health service registered
+func NewServer(projectService *projects.ProjectService, companyService *companies.CompanyService, defaultTimeout time.Duration) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			recoverPanics,
+			withDeadline(defaultTimeout),
+			withStatus,
+			withIdentity,
+		),
+	)
+
+	pb.RegisterProjectServiceServer(server, &projectServer{projectService: projectService})
+	pb.RegisterCompanyServiceServer(server, &companyServer{companyService: companyService})
+	pb.RegisterPermissionServiceServer(server, &permissionServer{projectService: projectService, companyService: companyService})
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	return server
+}
+
+// Serve listens on addr until ctx ends, then waits for in-flight calls to
+// finish before returning
+func Serve(ctx context.Context, server *grpc.Server, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+
+	log.Info("serve:start", "addr", addr)
+	return server.Serve(listener)
+}
@@ -0,0 +1,80 @@
+package search
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/search"
+)
+
+// Response DTOs
+type ProjectHitResponse struct {
+	ID                   uint    `json:"id"`
+	Title                string  `json:"title"`
+	Status               string  `json:"status"`
+	CompanyID            *string `json:"companyId,omitempty"`
+	Rank                 float64 `json:"rank"`
+	TitleHighlight       string  `json:"titleHighlight"`
+	DescriptionHighlight string  `json:"descriptionHighlight,omitempty"`
+}
+
+type CompanyHitResponse struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Type          string  `json:"type"`
+	Rank          float64 `json:"rank"`
+	NameHighlight string  `json:"nameHighlight"`
+}
+
+type MemberHitResponse struct {
+	CompanyID       string  `json:"companyId"`
+	UserID          string  `json:"userId"`
+	Role            string  `json:"role"`
+	Rank            float64 `json:"rank"`
+	UserIDHighlight string  `json:"userIdHighlight"`
+}
+
+type SearchResponse struct {
+	Query     string               `json:"query"`
+	Projects  []ProjectHitResponse `json:"projects"`
+	Companies []CompanyHitResponse `json:"companies"`
+	Members   []MemberHitResponse  `json:"members"`
+}
+
+func SearchResultsToResponse(results *search.SearchResults) SearchResponse {
+	response := SearchResponse{
+		Query:     results.Query,
+		Projects:  make([]ProjectHitResponse, len(results.Projects)),
+		Companies: make([]CompanyHitResponse, len(results.Companies)),
+		Members:   make([]MemberHitResponse, len(results.Members)),
+	}
+
+	for i, hit := range results.Projects {
+		response.Projects[i] = ProjectHitResponse{
+			ID:                   hit.ID,
+			Title:                hit.Title,
+			Status:               hit.Status,
+			CompanyID:            hit.CompanyID,
+			Rank:                 hit.Rank,
+			TitleHighlight:       hit.TitleHighlight,
+			DescriptionHighlight: hit.DescriptionHighlight,
+		}
+	}
+	for i, hit := range results.Companies {
+		response.Companies[i] = CompanyHitResponse{
+			ID:            hit.ID,
+			Name:          hit.Name,
+			Type:          hit.Type,
+			Rank:          hit.Rank,
+			NameHighlight: hit.NameHighlight,
+		}
+	}
+	for i, hit := range results.Members {
+		response.Members[i] = MemberHitResponse{
+			CompanyID:       hit.CompanyID,
+			UserID:          hit.UserID,
+			Role:            hit.Role,
+			Rank:            hit.Rank,
+			UserIDHighlight: hit.UserIDHighlight,
+		}
+	}
+
+	return response
+}
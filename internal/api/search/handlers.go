@@ -0,0 +1,61 @@
+package search
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/search"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	searchService *search.SearchService
+}
+
+func NewSearchHandler(searchService *search.SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+func (h *SearchHandler) Search(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	var options search.SearchOptions
+	if types := c.Query("types"); types != "" {
+		options.Types = strings.Split(types, ",")
+	}
+	if tags := c.Query("tags"); tags != "" {
+		options.Tags = strings.Split(tags, ",")
+	}
+	if limit := c.Query("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed <= 0 {
+			responses.BadRequest(c, "Invalid limit")
+			return
+		}
+		options.Limit = parsed
+	}
+
+	results, err := h.searchService.Search(c.Query("q"), userID, options)
+	if err != nil {
+		if err.Error() == "search query is required" || err.Error() == "invalid search type" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := SearchResultsToResponse(results)
+	responses.Success(c, "Search completed successfully", response)
+}
@@ -0,0 +1,17 @@
+package search
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/search"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers all search routes
+func RegisterRoutes(router *gin.RouterGroup, searchService *search.SearchService) {
+	handler := NewSearchHandler(searchService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/search")
+	{
+		internal.GET("", handler.Search) // Full-text search (query: userId, q, types, limit)
+	}
+}
@@ -0,0 +1,90 @@
+package tags
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+)
+
+// Request DTOs
+type CreateTagRequest struct {
+	CompanyID *string `json:"companyId"` // Omit for a personal tag
+	Name      string  `json:"name" binding:"required"`
+	Color     string  `json:"color"` // #rrggbb, defaults to grey
+	UserID    string  `json:"userId" binding:"required"`
+}
+
+type UpdateTagRequest struct {
+	Name   string `json:"name"`  // Omit to keep the current name
+	Color  string `json:"color"` // Omit to keep the current color
+	UserID string `json:"userId" binding:"required"`
+}
+
+type MergeTagRequest struct {
+	TargetID uint   `json:"targetId" binding:"required"` // Tag that receives the projects
+	UserID   string `json:"userId" binding:"required"`
+}
+
+type TagProjectRequest struct {
+	TagID  uint   `json:"tagId" binding:"required"`
+	UserID string `json:"userId" binding:"required"`
+}
+
+// Response DTOs
+type TagResponse struct {
+	ID        uint      `json:"id"`
+	CompanyID *string   `json:"companyId,omitempty"`
+	OwnerID   string    `json:"ownerId"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type ProjectTagResponse struct {
+	ProjectID uint      `json:"projectId"`
+	TagID     uint      `json:"tagId"`
+	TaggedBy  string    `json:"taggedBy"`
+	TaggedAt  time.Time `json:"taggedAt"`
+}
+
+// Use standardized list responses
+type TagListResponse = types.ListResponse[TagResponse]
+
+func (r *CreateTagRequest) ToTag() *db.Tag {
+	return &db.Tag{
+		CompanyID: r.CompanyID,
+		Name:      r.Name,
+		Color:     r.Color,
+	}
+}
+
+func TagToResponse(tag *db.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		CompanyID: tag.CompanyID,
+		OwnerID:   tag.OwnerID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func TagsToResponse(tags []db.Tag) []TagResponse {
+	responses := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = TagToResponse(&tag)
+	}
+	return responses
+}
+
+func ProjectTagToResponse(projectTag *db.ProjectTag) ProjectTagResponse {
+	return ProjectTagResponse{
+		ProjectID: projectTag.ProjectID,
+		TagID:     projectTag.TagID,
+		TaggedBy:  projectTag.TaggedBy,
+		TaggedAt:  projectTag.TaggedAt,
+	}
+}
@@ -0,0 +1,256 @@
+package tags
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/tags"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler struct {
+	tagService *tags.TagService
+}
+
+func NewTagHandler(tagService *tags.TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	var req CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	tag, err := h.tagService.CreateTag(req.ToTag(), req.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := TagToResponse(tag)
+	responses.Created(c, "Tag created successfully", response)
+}
+
+func (h *TagHandler) GetTags(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	var companyID *string
+	if id := c.Query("companyId"); id != "" {
+		companyID = &id
+	}
+
+	tagList, err := h.tagService.GetTags(companyID, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	tagResponses := TagsToResponse(tagList)
+	response := TagListResponse{
+		Data: tagResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(tagResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Tags retrieved successfully", response)
+}
+
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	var req UpdateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	tag, err := h.tagService.UpdateTag(uint(id), req.Name, req.Color, req.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := TagToResponse(tag)
+	responses.Success(c, "Tag updated successfully", response)
+}
+
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	if err := h.tagService.DeleteTag(uint(id), userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	responses.Success(c, "Tag deleted successfully", nil)
+}
+
+func (h *TagHandler) MergeTags(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	var req MergeTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	tag, err := h.tagService.MergeTags(uint(id), req.TargetID, req.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := TagToResponse(tag)
+	responses.Success(c, "Tags merged successfully", response)
+}
+
+func (h *TagHandler) GetProjectTags(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	tagList, err := h.tagService.GetProjectTags(uint(id), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	tagResponses := TagsToResponse(tagList)
+	response := TagListResponse{
+		Data: tagResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(tagResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Project tags retrieved successfully", response)
+}
+
+func (h *TagHandler) TagProject(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	var req TagProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	projectTag, err := h.tagService.TagProject(uint(id), req.TagID, req.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := ProjectTagToResponse(projectTag)
+	responses.Created(c, "Project tagged successfully", response)
+}
+
+func (h *TagHandler) UntagProject(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid project ID")
+		return
+	}
+
+	tagIDParam := c.Param("tagId")
+	tagID, err := strconv.ParseUint(tagIDParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	if err := h.tagService.UntagProject(uint(id), uint(tagID), userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	responses.Success(c, "Tag removed from project successfully", nil)
+}
+
+// Private helper methods
+
+func respondError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "user cannot manage tags in this company", "user cannot access this company",
+		"user cannot access this project", "user cannot update this project":
+		responses.Forbidden(c, err.Error())
+	case "tag not found", "company not found", "record not found", "project does not have this tag":
+		responses.NotFound(c, err.Error())
+	case "tag name already exists", "project already has this tag":
+		responses.Conflict(c, err.Error())
+	case "invalid tag name", "invalid tag color", "cannot merge a tag into itself",
+		"tags must belong to the same company or both be personal", "tag belongs to another company":
+		responses.BadRequest(c, err.Error())
+	default:
+		responses.InternalError(c, err.Error())
+	}
+}
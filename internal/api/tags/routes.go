@@ -0,0 +1,28 @@
+package tags
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/tags"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the tag routes
+func RegisterRoutes(router *gin.RouterGroup, tagService *tags.TagService) {
+	handler := NewTagHandler(tagService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/tags")
+	{
+		internal.POST("", handler.CreateTag)           // Create a company tag, or a personal one without companyId
+		internal.GET("", handler.GetTags)              // List tags (query: userId, companyId for company tags)
+		internal.PUT("/:id", handler.UpdateTag)        // Rename or recolor
+		internal.DELETE("/:id", handler.DeleteTag)     // Delete tag and remove it from projects (query: userId)
+		internal.POST("/:id/merge", handler.MergeTags) // Move the tag's projects to targetId and delete it
+	}
+
+	projects := router.Group("/internal/projects")
+	{
+		projects.GET("/:id/tags", handler.GetProjectTags)         // Company tags and the user's personal tags (query: userId)
+		projects.POST("/:id/tags", handler.TagProject)            // Apply a tag
+		projects.DELETE("/:id/tags/:tagId", handler.UntagProject) // Remove a tag (query: userId)
+	}
+}
@@ -0,0 +1,94 @@
+package teams
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+)
+
+// Request DTOs
+type CreateTeamRequest struct {
+	CompanyID        string  `json:"companyId" binding:"required"`
+	Name             string  `json:"name" binding:"required"`
+	Description      *string `json:"description"`
+	RequestingUserID string  `json:"requestingUserId" binding:"required"`
+}
+
+type UpdateTeamRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+type AddTeamMemberRequest struct {
+	UserID string `json:"userId" binding:"required"`
+}
+
+// Response DTOs
+type TeamResponse struct {
+	ID          uint      `json:"id"`
+	CompanyID   string    `json:"companyId"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	CreatedBy   string    `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type TeamMemberResponse struct {
+	ID              uint      `json:"id"`
+	TeamID          uint      `json:"teamId"`
+	CompanyMemberID uint      `json:"companyMemberId"`
+	UserID          string    `json:"userId"`
+	JoinedAt        time.Time `json:"joinedAt"`
+}
+
+// Use standardized list responses
+type TeamListResponse = types.ListResponse[TeamResponse]
+type TeamMemberListResponse = types.ListResponse[TeamMemberResponse]
+
+func (r *CreateTeamRequest) ToTeam() *db.Team {
+	return &db.Team{
+		CompanyID:   r.CompanyID,
+		Name:        r.Name,
+		Description: r.Description,
+	}
+}
+
+func TeamToResponse(team *db.Team) TeamResponse {
+	return TeamResponse{
+		ID:          team.ID,
+		CompanyID:   team.CompanyID,
+		Name:        team.Name,
+		Description: team.Description,
+		CreatedBy:   team.CreatedBy,
+		CreatedAt:   team.CreatedAt,
+		UpdatedAt:   team.UpdatedAt,
+	}
+}
+
+func TeamsToResponse(teams []db.Team) []TeamResponse {
+	responses := make([]TeamResponse, len(teams))
+	for i, team := range teams {
+		responses[i] = TeamToResponse(&team)
+	}
+	return responses
+}
+
+func MemberToResponse(member *db.TeamMember) TeamMemberResponse {
+	return TeamMemberResponse{
+		ID:              member.ID,
+		TeamID:          member.TeamID,
+		CompanyMemberID: member.CompanyMemberID,
+		UserID:          member.UserID,
+		JoinedAt:        member.JoinedAt,
+	}
+}
+
+func MembersToResponse(members []db.TeamMember) []TeamMemberResponse {
+	responses := make([]TeamMemberResponse, len(members))
+	for i, member := range members {
+		responses[i] = MemberToResponse(&member)
+	}
+	return responses
+}
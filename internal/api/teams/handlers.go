@@ -0,0 +1,311 @@
+package teams
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/teams"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type TeamHandler struct {
+	teamService *teams.TeamService
+}
+
+func NewTeamHandler(teamService *teams.TeamService) *TeamHandler {
+	return &TeamHandler{
+		teamService: teamService,
+	}
+}
+
+func (h *TeamHandler) CreateTeam(c *gin.Context) {
+	var req CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.teamService.CreateTeam(req.ToTeam(), req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot manage teams in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "team name already exists in this company" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := TeamToResponse(team)
+	responses.Created(c, "Team created successfully", response)
+}
+
+func (h *TeamHandler) GetTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	team, err := h.teamService.GetTeam(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot access this team" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.NotFound(c, err.Error())
+		return
+	}
+
+	response := TeamToResponse(team)
+	responses.Success(c, "Team retrieved successfully", response)
+}
+
+func (h *TeamHandler) UpdateTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+
+	var req struct {
+		UpdateTeamRequest
+		UserID string `json:"userId" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	teamUpdates := &db.Team{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	team, err := h.teamService.UpdateTeam(uint(id), teamUpdates, req.UserID)
+	if err != nil {
+		if err.Error() == "user cannot manage teams in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "team name already exists in this company" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := TeamToResponse(team)
+	responses.Success(c, "Team updated successfully", response)
+}
+
+func (h *TeamHandler) DeleteTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		var req struct {
+			UserID string `json:"userId"`
+		}
+		if err := c.ShouldBindJSON(&req); err == nil {
+			userID = req.UserID
+		}
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	err = h.teamService.DeleteTeam(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot manage teams in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Team deleted successfully", nil)
+}
+
+func (h *TeamHandler) GetCompanyTeams(c *gin.Context) {
+	companyID := c.Query("companyId")
+	if companyID == "" {
+		responses.BadRequest(c, "Company ID required")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	teams, err := h.teamService.GetCompanyTeams(companyID, userID)
+	if err != nil {
+		if err.Error() == "user cannot access this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	teamResponses := TeamsToResponse(teams)
+	response := types.ListResponse[TeamResponse]{
+		Data: teamResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(teamResponses),
+			Timestamp: time.Now(),
+		},
+	}
+
+	responses.Success(c, "Teams retrieved successfully", response)
+}
+
+func (h *TeamHandler) GetTeamMembers(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	members, err := h.teamService.GetTeamMembers(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot access this team" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	memberResponses := MembersToResponse(members)
+	response := types.ListResponse[TeamMemberResponse]{
+		Data: memberResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(memberResponses),
+			Timestamp: time.Now(),
+		},
+	}
+	responses.Success(c, "Members retrieved successfully", response)
+}
+
+func (h *TeamHandler) AddTeamMember(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+
+	var req struct {
+		AddTeamMemberRequest
+		RequestingUserID string `json:"requestingUserId" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	member, err := h.teamService.AddTeamMember(uint(id), req.UserID, req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot manage teams in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if err.Error() == "user is not an active member of this company" {
+			responses.BadRequest(c, err.Error())
+			return
+		}
+		if err.Error() == "user is already a member of this team" {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := MemberToResponse(member)
+	responses.Created(c, "Member added successfully", response)
+}
+
+func (h *TeamHandler) RemoveTeamMember(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid team ID")
+		return
+	}
+	userID := c.Param("userId")
+
+	requestingUserID := c.GetHeader("X-User-ID")
+	if requestingUserID == "" {
+		var req struct {
+			RequestingUserID string `json:"requestingUserId"`
+		}
+		if err := c.ShouldBindJSON(&req); err == nil {
+			requestingUserID = req.RequestingUserID
+		}
+	}
+
+	if requestingUserID == "" {
+		responses.BadRequest(c, "Requesting User ID required")
+		return
+	}
+
+	err = h.teamService.RemoveTeamMember(uint(id), userID, requestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot manage teams in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Member removed successfully", nil)
+}
@@ -0,0 +1,29 @@
+package teams
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/teams"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers all team-related routes
+func RegisterRoutes(router *gin.RouterGroup, teamService *teams.TeamService) {
+	handler := NewTeamHandler(teamService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/teams")
+	{
+		// Team CRUD
+		internal.POST("", handler.CreateTeam)       // Create team in a company
+		internal.GET("/:id", handler.GetTeam)       // Get team by ID
+		internal.PUT("/:id", handler.UpdateTeam)    // Update team
+		internal.DELETE("/:id", handler.DeleteTeam) // Delete team
+
+		// Company teams
+		internal.GET("", handler.GetCompanyTeams) // Get company's teams (query: companyId, userId)
+
+		// Team members
+		internal.GET("/:id/members", handler.GetTeamMembers)              // Get team members
+		internal.POST("/:id/members", handler.AddTeamMember)              // Add company member to team
+		internal.DELETE("/:id/members/:userId", handler.RemoveTeamMember) // Remove member from team
+	}
+}
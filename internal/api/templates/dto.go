@@ -0,0 +1,130 @@
+package templates
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+)
+
+// Request DTOs
+type TemplateMemberRequest struct {
+	UserID      string   `json:"userId" binding:"required"`
+	Role        string   `json:"role" binding:"required"`
+	Permissions []string `json:"permissions"`
+}
+
+type CreateTemplateRequest struct {
+	Name             string                  `json:"name" binding:"required"`
+	Title            string                  `json:"title" binding:"required"`
+	Description      *string                 `json:"description"`
+	CompanyID        *string                 `json:"companyId"`
+	DurationDays     *int                    `json:"durationDays"`
+	Members          []TemplateMemberRequest `json:"members"`
+	RequestingUserID string                  `json:"requestingUserId" binding:"required"`
+}
+
+type UpdateTemplateRequest struct {
+	Name         string                  `json:"name"`
+	Title        string                  `json:"title"`
+	Description  *string                 `json:"description"`
+	DurationDays *int                    `json:"durationDays"`
+	Members      []TemplateMemberRequest `json:"members"` // Replaces existing members when present
+}
+
+type CreateFromTemplateRequest struct {
+	Title     string     `json:"title"`
+	StartDate *time.Time `json:"startDate"`
+	CompanyID *string    `json:"companyId"`
+	OwnerID   string     `json:"ownerId" binding:"required"`
+}
+
+// Response DTOs
+type TemplateMemberResponse struct {
+	UserID      string   `json:"userId"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+type TemplateResponse struct {
+	ID           uint                     `json:"id"`
+	Name         string                   `json:"name"`
+	Title        string                   `json:"title"`
+	Description  *string                  `json:"description"`
+	OwnerID      string                   `json:"ownerId"`
+	CompanyID    *string                  `json:"companyId"`
+	DurationDays *int                     `json:"durationDays"`
+	Members      []TemplateMemberResponse `json:"members"`
+	CreatedAt    time.Time                `json:"createdAt"`
+	UpdatedAt    time.Time                `json:"updatedAt"`
+}
+
+// Use standardized list response
+type TemplateListResponse = types.ListResponse[TemplateResponse]
+
+func membersFromRequest(members []TemplateMemberRequest) []db.ProjectTemplateMember {
+	if members == nil {
+		return nil
+	}
+	result := make([]db.ProjectTemplateMember, len(members))
+	for i, member := range members {
+		result[i] = db.ProjectTemplateMember{
+			UserID:      member.UserID,
+			Role:        member.Role,
+			Permissions: member.Permissions,
+		}
+	}
+	return result
+}
+
+func (r *CreateTemplateRequest) ToTemplate() *db.ProjectTemplate {
+	return &db.ProjectTemplate{
+		Name:         r.Name,
+		Title:        r.Title,
+		Description:  r.Description,
+		CompanyID:    r.CompanyID,
+		DurationDays: r.DurationDays,
+		Members:      membersFromRequest(r.Members),
+	}
+}
+
+func (r *UpdateTemplateRequest) ToTemplate() *db.ProjectTemplate {
+	return &db.ProjectTemplate{
+		Name:         r.Name,
+		Title:        r.Title,
+		Description:  r.Description,
+		DurationDays: r.DurationDays,
+		Members:      membersFromRequest(r.Members),
+	}
+}
+
+func TemplateToResponse(template *db.ProjectTemplate) TemplateResponse {
+	members := make([]TemplateMemberResponse, len(template.Members))
+	for i, member := range template.Members {
+		members[i] = TemplateMemberResponse{
+			UserID:      member.UserID,
+			Role:        member.Role,
+			Permissions: member.Permissions,
+		}
+	}
+	return TemplateResponse{
+		ID:           template.ID,
+		Name:         template.Name,
+		Title:        template.Title,
+		Description:  template.Description,
+		OwnerID:      template.OwnerID,
+		CompanyID:    template.CompanyID,
+		DurationDays: template.DurationDays,
+		Members:      members,
+		CreatedAt:    template.CreatedAt,
+		UpdatedAt:    template.UpdatedAt,
+	}
+}
+
+func TemplatesToResponse(templates []db.ProjectTemplate) []TemplateResponse {
+	responses := make([]TemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = TemplateToResponse(&template)
+	}
+	return responses
+}
@@ -0,0 +1,212 @@
+package templates
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	projectsAPI "github.com/JorgeSaicoski/go-project-manager/internal/api/projects"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/templates"
+	"github.com/JorgeSaicoski/microservice-commons/responses"
+	"github.com/JorgeSaicoski/microservice-commons/types"
+	"github.com/gin-gonic/gin"
+)
+
+type TemplateHandler struct {
+	templateService *templates.TemplateService
+}
+
+func NewTemplateHandler(templateService *templates.TemplateService) *TemplateHandler {
+	return &TemplateHandler{
+		templateService: templateService,
+	}
+}
+
+func (h *TemplateHandler) CreateTemplate(c *gin.Context) {
+	var req CreateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	template, err := h.templateService.CreateTemplate(req.ToTemplate(), req.RequestingUserID)
+	if err != nil {
+		if err.Error() == "user cannot manage templates in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := TemplateToResponse(template)
+	responses.Created(c, "Template created successfully", response)
+}
+
+func (h *TemplateHandler) GetTemplate(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid template ID")
+		return
+	}
+
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	template, err := h.templateService.GetTemplate(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot access this template" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.NotFound(c, err.Error())
+		return
+	}
+
+	response := TemplateToResponse(template)
+	responses.Success(c, "Template retrieved successfully", response)
+}
+
+func (h *TemplateHandler) UpdateTemplate(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid template ID")
+		return
+	}
+
+	var req struct {
+		UpdateTemplateRequest
+		UserID string `json:"userId" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	template, err := h.templateService.UpdateTemplate(uint(id), req.ToTemplate(), req.UserID)
+	if err != nil {
+		if err.Error() == "user cannot update this template" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	response := TemplateToResponse(template)
+	responses.Success(c, "Template updated successfully", response)
+}
+
+func (h *TemplateHandler) DeleteTemplate(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid template ID")
+		return
+	}
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		var req struct {
+			UserID string `json:"userId"`
+		}
+		if err := c.ShouldBindJSON(&req); err == nil {
+			userID = req.UserID
+		}
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	err = h.templateService.DeleteTemplate(uint(id), userID)
+	if err != nil {
+		if err.Error() == "user cannot delete this template" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Success(c, "Template deleted successfully", nil)
+}
+
+func (h *TemplateHandler) GetUserTemplates(c *gin.Context) {
+	userID := c.Query("userId")
+	if userID == "" {
+		userID = c.GetHeader("X-User-ID")
+	}
+
+	if userID == "" {
+		responses.BadRequest(c, "User ID required")
+		return
+	}
+
+	templates, err := h.templateService.GetUserTemplates(userID)
+	if err != nil {
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	templateResponses := TemplatesToResponse(templates)
+	response := types.ListResponse[TemplateResponse]{
+		Data: templateResponses,
+		Meta: types.ResponseMetadata{
+			Count:     len(templateResponses),
+			Timestamp: time.Now(),
+		},
+	}
+
+	responses.Success(c, "Templates retrieved successfully", response)
+}
+
+func (h *TemplateHandler) CreateProjectFromTemplate(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.BadRequest(c, "Invalid template ID")
+		return
+	}
+
+	var req CreateFromTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.BadRequest(c, err.Error())
+		return
+	}
+
+	project, members, err := h.templateService.CreateProjectFromTemplate(uint(id), templates.InstantiateOptions{
+		Title:     req.Title,
+		StartDate: req.StartDate,
+		OwnerID:   req.OwnerID,
+		CompanyID: req.CompanyID,
+	})
+	if err != nil {
+		if err.Error() == "user cannot access this template" || err.Error() == "user cannot create projects in this company" {
+			responses.Forbidden(c, err.Error())
+			return
+		}
+		if strings.HasPrefix(err.Error(), "failed to add template member") {
+			responses.Conflict(c, err.Error())
+			return
+		}
+		responses.InternalError(c, err.Error())
+		return
+	}
+
+	responses.Created(c, "Project created from template successfully", gin.H{
+		"project": projectsAPI.ProjectToResponse(project),
+		"members": projectsAPI.MembersToResponse(members),
+	})
+}
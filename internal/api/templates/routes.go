@@ -0,0 +1,27 @@
+package templates
+
+import (
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/templates"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers all project template routes
+func RegisterRoutes(router *gin.RouterGroup, templateService *templates.TemplateService) {
+	handler := NewTemplateHandler(templateService)
+
+	// Internal API routes for service-to-service communication
+	internal := router.Group("/internal/templates")
+	{
+		// Template CRUD
+		internal.POST("", handler.CreateTemplate)       // Create template
+		internal.GET("/:id", handler.GetTemplate)       // Get template by ID
+		internal.PUT("/:id", handler.UpdateTemplate)    // Update template
+		internal.DELETE("/:id", handler.DeleteTemplate) // Delete template
+
+		// User templates
+		internal.GET("", handler.GetUserTemplates) // Get personal and company templates (query: userId)
+
+		// Instantiation
+		internal.POST("/:id/projects", handler.CreateProjectFromTemplate) // Create project from template
+	}
+}
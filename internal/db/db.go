@@ -9,23 +9,56 @@ type BaseProject struct {
 	Title       string     `json:"title"`
 	Description *string    `json:"description"`
 	Status      string     `json:"status"` // active, completed, paused, cancelled
-	OwnerID     string     `json:"ownerId"`
-	CompanyID   *string    `json:"companyId,omitempty"`
+	OwnerID     string     `json:"ownerId" gorm:"uniqueIndex:idx_project_owner_external_key,priority:1"`
+	CompanyID   *string    `json:"companyId,omitempty" gorm:"uniqueIndex:idx_project_company_external_key,priority:1"`
+	ParentID    *uint      `json:"parentId,omitempty" gorm:"index"` // nil for top-level projects
 	StartDate   *time.Time `json:"startDate"`
 	EndDate     *time.Time `json:"endDate"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`                                                                                                                                               // Set when status becomes completed
+	ExternalKey *string    `json:"externalKey,omitempty" gorm:"uniqueIndex:idx_project_owner_external_key,priority:2,where:company_id IS NULL;uniqueIndex:idx_project_company_external_key,priority:2"` // Caller-chosen key used by imports to upsert, unique per company or per owner for personal projects
+	ProjectType *string    `json:"projectType,omitempty" gorm:"index"`                                                                                                                                  // Registered type of the module extending this project, nil for plain projects
 	CreatedAt   time.Time  `json:"createdAt"`
 	UpdatedAt   time.Time  `json:"updatedAt"`
+
+	CustomFields map[string]interface{} `json:"customFields,omitempty" gorm:"serializer:json;type:jsonb"` // Values keyed by CustomFieldDefinition.Key
 }
 
 type ProjectMember struct {
 	ProjectID   string    `json:"projectId"`   // External project ID
-	ProjectType string    `json:"projectType"` // professional, education, finance
-	UserID      string    `json:"userId"`
+	ProjectType string    `json:"projectType"` // core, or the name of a registered ProjectType
+	UserID      string    `json:"userId" gorm:"index"`
 	Role        string    `json:"role"`
 	Permissions []string  `json:"permissions" gorm:"type:text[]"`
 	JoinedAt    time.Time `json:"joinedAt"`
 }
 
+// ProjectType is a kind of project owned by a specialized module, e.g.
+// professional or education. Members of its projects are ProjectMembers with
+// this ProjectType and the module's own project ID.
+type ProjectType struct {
+	Name         string    `json:"name" gorm:"primaryKey"`
+	Service      string    `json:"service"`               // Module that owns the projects, e.g. professional-tracker
+	CallbackURL  string    `json:"callbackUrl,omitempty"` // Base URL of the module's API
+	RegisteredAt time.Time `json:"registeredAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// CustomFieldDefinition declares a typed custom field for the projects of a
+// company or of a project type. Exactly one of CompanyID and ProjectType is set.
+type CustomFieldDefinition struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	CompanyID     *string   `json:"companyId,omitempty" gorm:"index"`
+	ProjectType   *string   `json:"projectType,omitempty" gorm:"index"`
+	Key           string    `json:"key"`
+	Label         string    `json:"label"`
+	Type          string    `json:"type"` // text, number, boolean, date, select, multiselect
+	Required      bool      `json:"required"`
+	AllowedValues []string  `json:"allowedValues" gorm:"type:text[]"` // Options of select and multiselect fields
+	CreatedBy     string    `json:"createdBy"`                        // User, or service for project type fields
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
 type Company struct {
 	ID      string          `json:"id" gorm:"primaryKey"`
 	Name    string          `json:"name"`
@@ -47,4 +80,200 @@ type CompanyMember struct {
 	// Company-specific data
 	Salary     *float64 `json:"salary,omitempty"`     // For employees
 	HourlyRate *float64 `json:"hourlyRate,omitempty"` // For freelancers/contractors
+	Currency   string   `json:"currency,omitempty"`   // ISO 4217 code for Salary and HourlyRate
+}
+
+type Team struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	CompanyID   string    `json:"companyId" gorm:"index"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	CreatedBy   string    `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type TeamMember struct {
+	ID              uint      `json:"id" gorm:"primaryKey"`
+	TeamID          uint      `json:"teamId" gorm:"index"`
+	CompanyMemberID uint      `json:"companyMemberId"` // Membership is sourced from CompanyMember
+	UserID          string    `json:"userId" gorm:"index"`
+	JoinedAt        time.Time `json:"joinedAt"`
+}
+
+// ProjectTeam grants every member of a team a role on a core project
+type ProjectTeam struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	ProjectID   uint      `json:"projectId" gorm:"index"`
+	TeamID      uint      `json:"teamId" gorm:"index"`
+	Role        string    `json:"role"`
+	Permissions []string  `json:"permissions" gorm:"type:text[]"`
+	GrantedBy   string    `json:"grantedBy"`
+	GrantedAt   time.Time `json:"grantedAt"`
+}
+
+// Tag labels core projects. Company tags are shared by the company's members;
+// personal tags, with CompanyID nil, are only seen by their owner and can be
+// applied to projects of any company.
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	CompanyID *string   `json:"companyId,omitempty" gorm:"index"`
+	OwnerID   string    `json:"ownerId" gorm:"index"` // Creator of a company tag, owner of a personal tag
+	Name      string    `json:"name"`
+	Color     string    `json:"color"` // #rrggbb
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ProjectTag applies a tag to a core project
+type ProjectTag struct {
+	ProjectID uint      `json:"projectId" gorm:"primaryKey"`
+	TagID     uint      `json:"tagId" gorm:"primaryKey;index"`
+	TaggedBy  string    `json:"taggedBy"`
+	TaggedAt  time.Time `json:"taggedAt"`
+}
+
+// ProjectTemplate is a reusable project skeleton owned by a company or, when
+// CompanyID is nil, by a single user.
+type ProjectTemplate struct {
+	ID           uint                    `json:"id" gorm:"primaryKey"`
+	Name         string                  `json:"name"`
+	Title        string                  `json:"title"`
+	Description  *string                 `json:"description"`
+	OwnerID      string                  `json:"ownerId" gorm:"index"`
+	CompanyID    *string                 `json:"companyId,omitempty" gorm:"index"`
+	DurationDays *int                    `json:"durationDays"` // EndDate offset from StartDate
+	Members      []ProjectTemplateMember `json:"members" gorm:"foreignKey:TemplateID"`
+	CreatedAt    time.Time               `json:"createdAt"`
+	UpdatedAt    time.Time               `json:"updatedAt"`
+}
+
+type ProjectTemplateMember struct {
+	ID          uint     `json:"id" gorm:"primaryKey"`
+	TemplateID  uint     `json:"templateId" gorm:"index"`
+	UserID      string   `json:"userId"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions" gorm:"type:text[]"`
+}
+
+// CompanyRole is a named role with default permissions, usually seeded from the company template
+type CompanyRole struct {
+	ID          uint     `json:"id" gorm:"primaryKey"`
+	CompanyID   string   `json:"companyId" gorm:"index"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions" gorm:"type:text[]"`
+}
+
+// CompensationRate is one effective-dated entry in a member's compensation
+// history. CompanyMember holds the rate currently in effect.
+type CompensationRate struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	CompanyID     string    `json:"companyId" gorm:"index"`
+	UserID        string    `json:"userId" gorm:"index"`
+	Salary        *float64  `json:"salary,omitempty"`
+	HourlyRate    *float64  `json:"hourlyRate,omitempty"`
+	Currency      string    `json:"currency"`
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	SetBy         string    `json:"setBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// ErasureRecord documents a completed user erasure without keeping the user ID.
+// Digest is a SHA-256 over the other fields so later edits are detectable.
+type ErasureRecord struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	SubjectHash string    `json:"subjectHash" gorm:"index"` // SHA-256 of the erased user ID
+	Pseudonym   string    `json:"pseudonym"`                // Replaces the user ID where references are kept
+	Policy      string    `json:"policy" gorm:"type:jsonb"`
+	Actions     string    `json:"actions" gorm:"type:jsonb"`
+	PerformedAt time.Time `json:"performedAt"`
+	Digest      string    `json:"digest"`
+}
+
+// CalendarToken authorizes an ICS feed subscription. Only a SHA-256 of the
+// token is stored; the token itself is shown once, when it is created.
+type CalendarToken struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	TokenHash  string     `json:"-" gorm:"uniqueIndex"`
+	UserID     string     `json:"userId" gorm:"index"`
+	CompanyID  *string    `json:"companyId,omitempty"` // nil for a feed of the user's own projects
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+}
+
+// Notification is a message for one user. DedupKey is set for events that
+// must only be delivered once, such as deadline reminders.
+type Notification struct {
+	ID        uint              `json:"id" gorm:"primaryKey"`
+	UserID    string            `json:"userId" gorm:"index;uniqueIndex:idx_notification_dedup"`
+	Type      string            `json:"type"` // member_added, role_changed, invitation_received, project_status_changed, deadline_approaching, project_overdue
+	Title     string            `json:"title"`
+	Body      string            `json:"body"`
+	Data      map[string]string `json:"data,omitempty" gorm:"serializer:json;type:jsonb"` // Values the message was built from
+	ProjectID *uint             `json:"projectId,omitempty"`
+	CompanyID *string           `json:"companyId,omitempty"`
+	DedupKey  *string           `json:"-" gorm:"uniqueIndex:idx_notification_dedup"`
+	CreatedAt time.Time         `json:"createdAt" gorm:"index"`
+	ReadAt    *time.Time        `json:"readAt"`
+}
+
+// NotificationPreference turns one notification type on or off for a user.
+// Types without a preference are delivered.
+type NotificationPreference struct {
+	UserID    string    `json:"userId" gorm:"primaryKey"`
+	Type      string    `json:"type" gorm:"primaryKey"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// EmailSettings is where and in which language a user gets email notifications
+type EmailSettings struct {
+	UserID         string     `json:"userId" gorm:"primaryKey"`
+	Email          string     `json:"email"`
+	Locale         string     `json:"locale"`
+	UnsubscribedAt *time.Time `json:"unsubscribedAt"` // Set by an unsubscribe link; no email is sent while set
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// EmailMessage is a rendered email in the send queue
+type EmailMessage struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	UserID         string     `json:"userId" gorm:"index"`
+	NotificationID *uint      `json:"notificationId,omitempty"`
+	To             string     `json:"to"`
+	Subject        string     `json:"subject"`
+	TextBody       string     `json:"textBody"`
+	HTMLBody       string     `json:"htmlBody"`
+	UnsubscribeURL string     `json:"-"`
+	Status         string     `json:"status" gorm:"index"` // pending, sent, failed, cancelled
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastError      string     `json:"lastError,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	SentAt         *time.Time `json:"sentAt"`
+}
+
+// ChangeEvent is one entry in the log of project and company changes. IDs
+// come from a sequence, so clients resume a stream from the last ID they saw.
+type ChangeEvent struct {
+	ID         int64       `json:"id" gorm:"primaryKey;autoIncrement"`
+	EntityType string      `json:"entityType"` // project, company
+	Action     string      `json:"action"`     // created, updated, moved, owner_changed, deleted, member_added, member_updated, member_removed, member_invited, team_added, team_removed, team_member_added, team_member_removed
+	ProjectID  *uint       `json:"projectId,omitempty" gorm:"index"`
+	CompanyID  *string     `json:"companyId,omitempty" gorm:"index"`
+	ActorID    string      `json:"actorId"`          // User who made the change
+	UserID     string      `json:"userId,omitempty"` // Member the change is about, for member events
+	Payload    interface{} `json:"payload" gorm:"serializer:json;type:jsonb"`
+	CreatedAt  time.Time   `json:"createdAt" gorm:"index"`
+}
+
+// ProjectPresence is one collaboration connection viewing a project. Rows
+// are refreshed while the connection is open and expire if its server dies.
+type ProjectPresence struct {
+	ConnectionID string    `json:"connectionId" gorm:"primaryKey"`
+	ProjectID    uint      `json:"projectId" gorm:"primaryKey;index"`
+	UserID       string    `json:"userId" gorm:"index"`
+	SeenAt       time.Time `json:"seenAt" gorm:"index"`
 }
@@ -0,0 +1,208 @@
+package analytics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+type AnalyticsService struct {
+	database    *pgconnect.DB
+	companyRepo *pgconnect.Repository[db.Company]
+}
+
+func NewAnalyticsService(database *pgconnect.DB) *AnalyticsService {
+	return &AnalyticsService{
+		database:    database,
+		companyRepo: pgconnect.NewRepository[db.Company](database),
+	}
+}
+
+// Trend intervals accepted by date_trunc
+var validIntervals = map[string]bool{
+	"day":   true,
+	"week":  true,
+	"month": true,
+}
+
+type TrendOptions struct {
+	Since    time.Time
+	Interval string // day, week or month
+}
+
+type CompanyAnalytics struct {
+	CompanyID         string
+	ProjectsByStatus  map[string]int64
+	OverdueProjects   int64
+	MembersByRole     map[string]int64
+	MembersByStatus   map[string]int64
+	ProjectsPerMember []MemberProjectCount
+	Interval          string
+	Since             time.Time
+	Created           []TrendPoint
+	Completed         []TrendPoint
+	GeneratedAt       time.Time
+}
+
+type MemberProjectCount struct {
+	UserID       string
+	Role         string
+	ProjectCount int64
+}
+
+type TrendPoint struct {
+	Period time.Time
+	Count  int64
+}
+
+type groupCount struct {
+	Key   string
+	Count int64
+}
+
+func (s *AnalyticsService) GetCompanyAnalytics(companyID, userID string, options TrendOptions) (*CompanyAnalytics, error) {
+	var company db.Company
+	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
+		return nil, err
+	}
+
+	if !s.userCanViewAnalytics(userID, &company) {
+		return nil, errors.New("user cannot view analytics for this company")
+	}
+
+	if options.Interval == "" {
+		options.Interval = "month"
+	}
+	if !validIntervals[options.Interval] {
+		return nil, errors.New("invalid trend interval")
+	}
+	if options.Since.IsZero() {
+		options.Since = time.Now().AddDate(-1, 0, 0)
+	}
+
+	analytics := &CompanyAnalytics{
+		CompanyID:   companyID,
+		Interval:    options.Interval,
+		Since:       options.Since,
+		GeneratedAt: time.Now(),
+	}
+	var err error
+
+	analytics.ProjectsByStatus, err = s.countBy(`
+		SELECT status AS key, COUNT(*) AS count
+		FROM base_projects
+		WHERE company_id = ?
+		GROUP BY status`, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overdue: still active after the end date
+	err = s.database.Raw(`
+		SELECT COUNT(*)
+		FROM base_projects
+		WHERE company_id = ? AND status = 'active' AND end_date < ?`, companyID, analytics.GeneratedAt).
+		Scan(&analytics.OverdueProjects).Error
+	if err != nil {
+		return nil, err
+	}
+
+	analytics.MembersByRole, err = s.countBy(`
+		SELECT role AS key, COUNT(*) AS count
+		FROM company_members
+		WHERE company_id = ?
+		GROUP BY role`, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics.MembersByStatus, err = s.countBy(`
+		SELECT status AS key, COUNT(*) AS count
+		FROM company_members
+		WHERE company_id = ?
+		GROUP BY status`, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A member works on a company project when they own it or are a core project member
+	err = s.database.Raw(`
+		SELECT cm.user_id, cm.role, COUNT(DISTINCT bp.id) AS project_count
+		FROM company_members cm
+		LEFT JOIN base_projects bp
+			ON bp.company_id = cm.company_id
+			AND (
+				bp.owner_id = cm.user_id
+				OR EXISTS (
+					SELECT 1 FROM project_members pm
+					WHERE pm.project_type = 'core'
+						AND pm.project_id = CAST(bp.id AS TEXT)
+						AND pm.user_id = cm.user_id
+				)
+			)
+		WHERE cm.company_id = ?
+		GROUP BY cm.user_id, cm.role
+		ORDER BY project_count DESC, cm.user_id`, companyID).
+		Scan(&analytics.ProjectsPerMember).Error
+	if err != nil {
+		return nil, err
+	}
+
+	analytics.Created, err = s.trend("created_at", companyID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics.Completed, err = s.trend("completed_at", companyID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics, nil
+}
+
+// Private helper methods
+
+func (s *AnalyticsService) countBy(query string, args ...interface{}) (map[string]int64, error) {
+	var rows []groupCount
+	if err := s.database.Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Key] = row.Count
+	}
+	return counts, nil
+}
+
+// trend buckets projects by a timestamp column. column is always one of our
+// own constants, never user input.
+func (s *AnalyticsService) trend(column, companyID string, options TrendOptions) ([]TrendPoint, error) {
+	var points []TrendPoint
+	err := s.database.Raw(`
+		SELECT date_trunc(?, `+column+`) AS period, COUNT(*) AS count
+		FROM base_projects
+		WHERE company_id = ? AND `+column+` >= ?
+		GROUP BY period
+		ORDER BY period`, options.Interval, companyID, options.Since).
+		Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+func (s *AnalyticsService) userCanViewAnalytics(userID string, company *db.Company) bool {
+	// Owner can always view
+	if company.OwnerID == userID {
+		return true
+	}
+
+	// Business rule: roles that manage members see company-wide statistics
+	allowed, err := companies.HasPermission(s.database, company.ID, userID, companies.ManageMembersPermission)
+	return err == nil && allowed
+}
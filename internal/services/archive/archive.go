@@ -0,0 +1,482 @@
+package archive
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+// FormatVersion is written to every manifest; imports refuse other versions
+const FormatVersion = 1
+
+// Archive entries. Every entry except the manifest and company is JSON Lines,
+// one model per line, as serialized by the db package.
+const (
+	manifestEntry          = "manifest.json"
+	companyEntry           = "company.json"
+	companyMembersEntry    = "company_members.jsonl"
+	companyRolesEntry      = "company_roles.jsonl"
+	compensationRatesEntry = "compensation_rates.jsonl"
+	projectsEntry          = "projects.jsonl"
+	projectMembersEntry    = "project_members.jsonl"
+	teamsEntry             = "teams.jsonl"
+	teamMembersEntry       = "team_members.jsonl"
+	projectTeamsEntry      = "project_teams.jsonl"
+	customFieldsEntry      = "custom_field_definitions.jsonl"
+	tagsEntry              = "tags.jsonl"
+	projectTagsEntry       = "project_tags.jsonl"
+)
+
+type Manifest struct {
+	FormatVersion int            `json:"formatVersion"`
+	CompanyID     string         `json:"companyId"`
+	ExportedAt    time.Time      `json:"exportedAt"`
+	ExportedBy    string         `json:"exportedBy"`
+	Counts        map[string]int `json:"counts"` // Lines per entry
+}
+
+type ArchiveService struct {
+	database    *pgconnect.DB
+	companyRepo *pgconnect.Repository[db.Company]
+}
+
+func NewArchiveService(database *pgconnect.DB) *ArchiveService {
+	return &ArchiveService{
+		database:    database,
+		companyRepo: pgconnect.NewRepository[db.Company](database),
+	}
+}
+
+// ImportOptions controls how an archive is restored
+type ImportOptions struct {
+	CompanyID string // New company ID; defaults to the archived one
+}
+
+// ImportResult maps archived IDs to the IDs assigned on import
+type ImportResult struct {
+	CompanyID  string
+	Counts     map[string]int
+	ProjectIDs map[uint]uint
+	TeamIDs    map[uint]uint
+	MemberIDs  map[uint]uint // CompanyMember IDs
+	TagIDs     map[uint]uint
+}
+
+// ExportCompany streams a zip archive of the company and everything scoped to
+// it. It holds compensation data, so only the company owner may export.
+func (s *ArchiveService) ExportCompany(companyID, userID string, w io.Writer) error {
+	var company db.Company
+	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
+		return err
+	}
+	if company.OwnerID != userID {
+		return errors.New("only company owner can export company")
+	}
+
+	archive := zip.NewWriter(w)
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		CompanyID:     companyID,
+		ExportedAt:    time.Now(),
+		ExportedBy:    userID,
+		Counts:        make(map[string]int),
+	}
+
+	if err := writeJSON(archive, companyEntry, &company); err != nil {
+		return err
+	}
+
+	entries := []struct {
+		name  string
+		write func(name string) (int, error)
+	}{
+		{companyMembersEntry, func(name string) (int, error) {
+			return writeLines[db.CompanyMember](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{companyRolesEntry, func(name string) (int, error) {
+			return writeLines[db.CompanyRole](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{compensationRatesEntry, func(name string) (int, error) {
+			return writeLines[db.CompensationRate](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{projectsEntry, func(name string) (int, error) {
+			return writeLines[db.BaseProject](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{projectMembersEntry, func(name string) (int, error) {
+			projectIDs := s.database.Model(&db.BaseProject{}).Select("CAST(id AS TEXT)").Where("company_id = ?", companyID)
+			return writeLines[db.ProjectMember](archive, name, s.database.Where("project_type = ? AND project_id IN (?)", "core", projectIDs))
+		}},
+		{teamsEntry, func(name string) (int, error) {
+			return writeLines[db.Team](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{teamMembersEntry, func(name string) (int, error) {
+			teamIDs := s.database.Model(&db.Team{}).Select("id").Where("company_id = ?", companyID)
+			return writeLines[db.TeamMember](archive, name, s.database.Where("team_id IN (?)", teamIDs).Order("id"))
+		}},
+		{projectTeamsEntry, func(name string) (int, error) {
+			projectIDs := s.database.Model(&db.BaseProject{}).Select("id").Where("company_id = ?", companyID)
+			return writeLines[db.ProjectTeam](archive, name, s.database.Where("project_id IN (?)", projectIDs).Order("id"))
+		}},
+		{customFieldsEntry, func(name string) (int, error) {
+			return writeLines[db.CustomFieldDefinition](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{tagsEntry, func(name string) (int, error) {
+			return writeLines[db.Tag](archive, name, s.database.Where("company_id = ?", companyID).Order("id"))
+		}},
+		{projectTagsEntry, func(name string) (int, error) {
+			// Personal tags on company projects belong to their owners and stay out
+			tagIDs := s.database.Model(&db.Tag{}).Select("id").Where("company_id = ?", companyID)
+			return writeLines[db.ProjectTag](archive, name, s.database.Where("tag_id IN (?)", tagIDs).Order("project_id, tag_id"))
+		}},
+	}
+	for _, entry := range entries {
+		count, err := entry.write(entry.name)
+		if err != nil {
+			return err
+		}
+		manifest.Counts[entry.name] = count
+	}
+
+	// The manifest goes last so it can carry the final counts
+	if err := writeJSON(archive, manifestEntry, &manifest); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// ImportCompany restores an archive in a single transaction. Numeric IDs are
+// reassigned and every reference is remapped; user IDs are kept as they are.
+// The requesting user must be the archived company's owner.
+func (s *ArchiveService) ImportCompany(r io.ReaderAt, size int64, options ImportOptions, userID string) (*ImportResult, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("invalid archive: " + err.Error())
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	var manifest Manifest
+	if err := readJSON(files, manifestEntry, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return nil, errors.New("unsupported archive version " + strconv.Itoa(manifest.FormatVersion))
+	}
+
+	var company db.Company
+	if err := readJSON(files, companyEntry, &company); err != nil {
+		return nil, err
+	}
+	if company.OwnerID != userID {
+		return nil, errors.New("only company owner can import company")
+	}
+	if options.CompanyID != "" {
+		company.ID = options.CompanyID
+	}
+	company.Members = nil
+
+	var existing db.Company
+	if err := s.companyRepo.FindByID(company.ID, &existing); err == nil {
+		return nil, errors.New("company already exists")
+	}
+
+	result := &ImportResult{
+		CompanyID:  company.ID,
+		Counts:     make(map[string]int),
+		ProjectIDs: make(map[uint]uint),
+		TeamIDs:    make(map[uint]uint),
+		MemberIDs:  make(map[uint]uint),
+		TagIDs:     make(map[uint]uint),
+	}
+
+	err = s.database.WithTransaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&company).Error; err != nil {
+			return err
+		}
+
+		count, err := readLines(files, companyMembersEntry, func(member *db.CompanyMember) error {
+			oldID := member.ID
+			member.ID = 0
+			member.CompanyID = company.ID
+			if err := tx.Create(member).Error; err != nil {
+				return err
+			}
+			result.MemberIDs[oldID] = member.ID
+			return nil
+		})
+		result.Counts[companyMembersEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, companyRolesEntry, func(role *db.CompanyRole) error {
+			role.ID = 0
+			role.CompanyID = company.ID
+			return tx.Create(role).Error
+		})
+		result.Counts[companyRolesEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, compensationRatesEntry, func(rate *db.CompensationRate) error {
+			rate.ID = 0
+			rate.CompanyID = company.ID
+			return tx.Create(rate).Error
+		})
+		result.Counts[compensationRatesEntry] = count
+		if err != nil {
+			return err
+		}
+
+		// Parents may come after their children, so links are restored once every project exists
+		parents := make(map[uint]uint)
+		count, err = readLines(files, projectsEntry, func(project *db.BaseProject) error {
+			oldID := project.ID
+			project.ID = 0
+			project.CompanyID = &company.ID
+			oldParent := project.ParentID
+			project.ParentID = nil
+			if err := tx.Create(project).Error; err != nil {
+				return err
+			}
+			result.ProjectIDs[oldID] = project.ID
+			if oldParent != nil {
+				parents[project.ID] = *oldParent
+			}
+			return nil
+		})
+		result.Counts[projectsEntry] = count
+		if err != nil {
+			return err
+		}
+		for projectID, oldParent := range parents {
+			parentID, ok := result.ProjectIDs[oldParent]
+			if !ok {
+				return errors.New("invalid archive: project " + strconv.Itoa(int(projectID)) + " references a missing parent")
+			}
+			if err := tx.Model(&db.BaseProject{}).Where("id = ?", projectID).Update("parent_id", parentID).Error; err != nil {
+				return err
+			}
+		}
+
+		// Only core memberships are exported; rows for other project types are not trusted
+		count, err = readLines(files, projectMembersEntry, func(member *db.ProjectMember) error {
+			if member.UserID == "" || member.Role == "" {
+				return errors.New("invalid archive: project member is missing a user or role")
+			}
+			oldID, err := strconv.ParseUint(member.ProjectID, 10, 32)
+			if err != nil {
+				return errors.New("invalid archive: bad project member project ID")
+			}
+			projectID, ok := result.ProjectIDs[uint(oldID)]
+			if !ok {
+				return errors.New("invalid archive: project member references a missing project")
+			}
+			member.ProjectID = strconv.Itoa(int(projectID))
+			member.ProjectType = "core"
+			return tx.Create(member).Error
+		})
+		result.Counts[projectMembersEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, teamsEntry, func(team *db.Team) error {
+			oldID := team.ID
+			team.ID = 0
+			team.CompanyID = company.ID
+			if err := tx.Create(team).Error; err != nil {
+				return err
+			}
+			result.TeamIDs[oldID] = team.ID
+			return nil
+		})
+		result.Counts[teamsEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, teamMembersEntry, func(member *db.TeamMember) error {
+			teamID, ok := result.TeamIDs[member.TeamID]
+			if !ok {
+				return errors.New("invalid archive: team member references a missing team")
+			}
+			companyMemberID, ok := result.MemberIDs[member.CompanyMemberID]
+			if !ok {
+				return errors.New("invalid archive: team member references a missing company member")
+			}
+			member.ID = 0
+			member.TeamID = teamID
+			member.CompanyMemberID = companyMemberID
+			return tx.Create(member).Error
+		})
+		result.Counts[teamMembersEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, projectTeamsEntry, func(grant *db.ProjectTeam) error {
+			projectID, ok := result.ProjectIDs[grant.ProjectID]
+			if !ok {
+				return errors.New("invalid archive: project team references a missing project")
+			}
+			teamID, ok := result.TeamIDs[grant.TeamID]
+			if !ok {
+				return errors.New("invalid archive: project team references a missing team")
+			}
+			grant.ID = 0
+			grant.ProjectID = projectID
+			grant.TeamID = teamID
+			return tx.Create(grant).Error
+		})
+		result.Counts[projectTeamsEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, customFieldsEntry, func(definition *db.CustomFieldDefinition) error {
+			definition.ID = 0
+			definition.CompanyID = &company.ID
+			definition.ProjectType = nil
+			return tx.Create(definition).Error
+		})
+		result.Counts[customFieldsEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, tagsEntry, func(tag *db.Tag) error {
+			oldID := tag.ID
+			tag.ID = 0
+			tag.CompanyID = &company.ID
+			if err := tx.Create(tag).Error; err != nil {
+				return err
+			}
+			result.TagIDs[oldID] = tag.ID
+			return nil
+		})
+		result.Counts[tagsEntry] = count
+		if err != nil {
+			return err
+		}
+
+		count, err = readLines(files, projectTagsEntry, func(projectTag *db.ProjectTag) error {
+			projectID, ok := result.ProjectIDs[projectTag.ProjectID]
+			if !ok {
+				return errors.New("invalid archive: project tag references a missing project")
+			}
+			tagID, ok := result.TagIDs[projectTag.TagID]
+			if !ok {
+				return errors.New("invalid archive: project tag references a missing tag")
+			}
+			projectTag.ProjectID = projectID
+			projectTag.TagID = tagID
+			return tx.Create(projectTag).Error
+		})
+		result.Counts[projectTagsEntry] = count
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Private helper methods
+
+func writeJSON(archive *zip.Writer, name string, value interface{}) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// writeLines streams query results into a JSON Lines entry row by row
+func writeLines[T any](archive *zip.Writer, name string, query *gorm.DB) (int, error) {
+	w, err := archive.Create(name)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := query.Model(new(T)).Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	encoder := json.NewEncoder(w)
+	count := 0
+	for rows.Next() {
+		var item T
+		if err := query.ScanRows(rows, &item); err != nil {
+			return count, err
+		}
+		if err := encoder.Encode(&item); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+func readJSON(files map[string]*zip.File, name string, value interface{}) error {
+	file, ok := files[name]
+	if !ok {
+		return errors.New("invalid archive: missing " + name)
+	}
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err := json.NewDecoder(r).Decode(value); err != nil {
+		return errors.New("invalid archive: " + name + ": " + err.Error())
+	}
+	return nil
+}
+
+// readLines decodes a JSON Lines entry one line at a time. Missing entries
+// are treated as empty so archives stay importable as entries are added.
+func readLines[T any](files map[string]*zip.File, name string, handle func(item *T) error) (int, error) {
+	file, ok := files[name]
+	if !ok {
+		return 0, nil
+	}
+	r, err := file.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	decoder := json.NewDecoder(r)
+	count := 0
+	for {
+		var item T
+		err := decoder.Decode(&item)
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, errors.New("invalid archive: " + name + " line " + strconv.Itoa(count+1) + ": " + err.Error())
+		}
+		if err := handle(&item); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
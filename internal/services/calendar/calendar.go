@@ -0,0 +1,166 @@
+package calendar
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+// FeedHistory limits feeds to projects that ended within this window, so
+// years of finished work don't bloat every calendar sync
+const FeedHistory = 365 * 24 * time.Hour
+
+// tokenPrefix makes feed tokens recognizable, e.g. in secret scanners
+const tokenPrefix = "cal_"
+
+type CalendarService struct {
+	projectService    *projects.ProjectService
+	tokenRepo         *pgconnect.Repository[db.CalendarToken]
+	projectRepo       *pgconnect.Repository[db.BaseProject]
+	companyRepo       *pgconnect.Repository[db.Company]
+	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+	uidDomain         string
+}
+
+// NewCalendarService creates the service. uidDomain is the right-hand side of
+// every event UID and must stay the same for UIDs to remain stable.
+func NewCalendarService(database *pgconnect.DB, projectService *projects.ProjectService, uidDomain string) *CalendarService {
+	return &CalendarService{
+		projectService:    projectService,
+		tokenRepo:         pgconnect.NewRepository[db.CalendarToken](database),
+		projectRepo:       pgconnect.NewRepository[db.BaseProject](database),
+		companyRepo:       pgconnect.NewRepository[db.Company](database),
+		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+		uidDomain:         uidDomain,
+	}
+}
+
+// CreateToken issues a feed token for the user's projects, or for a company's
+// projects when companyID is set. The plain token is only returned here.
+func (s *CalendarService) CreateToken(userID string, companyID *string, name string) (*db.CalendarToken, string, error) {
+	if companyID != nil && !s.userIsActiveCompanyMember(userID, *companyID) {
+		return nil, "", errors.New("user cannot access this company")
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+	token := tokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	if name == "" {
+		name = "Projects"
+	}
+	calendarToken := &db.CalendarToken{
+		TokenHash: hashToken(token),
+		UserID:    userID,
+		CompanyID: companyID,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+	if err := s.tokenRepo.Create(calendarToken); err != nil {
+		return nil, "", err
+	}
+
+	return calendarToken, token, nil
+}
+
+func (s *CalendarService) GetUserTokens(userID string) ([]db.CalendarToken, error) {
+	var tokens []db.CalendarToken
+	if err := s.tokenRepo.FindWhere(&tokens, "user_id = ?", userID); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// RevokeToken disables a token immediately; revoked tokens are kept for auditing
+func (s *CalendarService) RevokeToken(id uint, userID string) error {
+	var token db.CalendarToken
+	if err := s.tokenRepo.FindByID(id, &token); err != nil {
+		return err
+	}
+	if token.UserID != userID {
+		return errors.New("user cannot revoke this token")
+	}
+	if token.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	token.RevokedAt = &now
+	return s.tokenRepo.Update(&token)
+}
+
+// WriteFeed authenticates a feed token and writes the ICS calendar. Access is
+// checked on every request, so a user who leaves a company stops seeing it.
+func (s *CalendarService) WriteFeed(token, style string, w io.Writer) error {
+	if style == "" {
+		style = StyleMilestones
+	}
+	if style != StyleMilestones && style != StyleSpan {
+		return errors.New("invalid calendar style")
+	}
+
+	var calendarToken db.CalendarToken
+	if err := s.tokenRepo.FindOne(&calendarToken, "token_hash = ? AND revoked_at IS NULL", hashToken(token)); err != nil {
+		return errors.New("invalid calendar token")
+	}
+
+	var projectList []db.BaseProject
+	name := calendarToken.Name
+	if calendarToken.CompanyID != nil {
+		if !s.userIsActiveCompanyMember(calendarToken.UserID, *calendarToken.CompanyID) {
+			return errors.New("invalid calendar token")
+		}
+		if err := s.projectRepo.FindWhere(&projectList, "company_id = ?", *calendarToken.CompanyID); err != nil {
+			return err
+		}
+		var company db.Company
+		if err := s.companyRepo.FindByID(*calendarToken.CompanyID, &company); err == nil {
+			name = company.Name + " - " + name
+		}
+	} else {
+		var err error
+		if projectList, err = s.projectService.GetUserProjects(calendarToken.UserID, projects.ProjectFilter{}); err != nil {
+			return err
+		}
+	}
+
+	cutoff := time.Now().Add(-FeedHistory)
+	var events []event
+	for i := range projectList {
+		project := &projectList[i]
+		if project.EndDate != nil && project.EndDate.Before(cutoff) {
+			continue
+		}
+		events = append(events, projectEvents(project, style, s.uidDomain)...)
+	}
+
+	// Usage tracking is best effort and never fails the feed
+	now := time.Now()
+	calendarToken.LastUsedAt = &now
+	s.tokenRepo.Update(&calendarToken)
+
+	return writeCalendar(w, name, events)
+}
+
+// Private helper methods
+
+func (s *CalendarService) userIsActiveCompanyMember(userID, companyID string) bool {
+	var member db.CompanyMember
+	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
+	return err == nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,156 @@
+package calendar
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// Feed styles
+const (
+	// StyleMilestones emits an all-day event on the start date and another on the end date
+	StyleMilestones = "milestones"
+	// StyleSpan emits one all-day event covering the whole project, falling back to milestones
+	StyleSpan = "span"
+)
+
+const (
+	icsDate      = "20060102"
+	icsTimestamp = "20060102T150405Z"
+	// RFC 5545 §3.1: lines longer than 75 octets are folded
+	maxLineOctets = 75
+)
+
+// event is one VEVENT; Start and End are all-day dates, End exclusive
+type event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Stamp       time.Time
+	Cancelled   bool
+}
+
+// projectEvents turns a project into calendar events. UIDs only depend on the
+// project ID and the event kind, so clients update events instead of duplicating them.
+func projectEvents(project *db.BaseProject, style, uidDomain string) []event {
+	base := event{
+		Description: "Status: " + project.Status,
+		Stamp:       project.UpdatedAt,
+		Cancelled:   project.Status == "cancelled",
+	}
+	if project.Description != nil && *project.Description != "" {
+		base.Description = *project.Description + "\n\n" + base.Description
+	}
+	id := strconv.FormatUint(uint64(project.ID), 10)
+
+	if style == StyleSpan && project.StartDate != nil && project.EndDate != nil && !project.EndDate.Before(*project.StartDate) {
+		span := base
+		span.UID = "project-" + id + "@" + uidDomain
+		span.Summary = project.Title
+		span.Start = dateOf(*project.StartDate)
+		span.End = dateOf(*project.EndDate).AddDate(0, 0, 1)
+		return []event{span}
+	}
+
+	var events []event
+	if project.StartDate != nil {
+		start := base
+		start.UID = "project-" + id + "-start@" + uidDomain
+		start.Summary = "Start: " + project.Title
+		start.Start = dateOf(*project.StartDate)
+		start.End = start.Start.AddDate(0, 0, 1)
+		events = append(events, start)
+	}
+	if project.EndDate != nil {
+		end := base
+		end.UID = "project-" + id + "-end@" + uidDomain
+		end.Summary = "Due: " + project.Title
+		end.Start = dateOf(*project.EndDate)
+		end.End = end.Start.AddDate(0, 0, 1)
+		events = append(events, end)
+	}
+	return events
+}
+
+// writeCalendar renders a VCALENDAR with CRLF line endings and folded lines
+func writeCalendar(w io.Writer, name string, events []event) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//project-core//Project Calendar//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + escapeText(name),
+	}
+	for _, e := range events {
+		status := "CONFIRMED"
+		if e.Cancelled {
+			status = "CANCELLED"
+		}
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+e.UID,
+			"DTSTAMP:"+e.Stamp.UTC().Format(icsTimestamp),
+			"DTSTART;VALUE=DATE:"+e.Start.Format(icsDate),
+			"DTEND;VALUE=DATE:"+e.End.Format(icsDate),
+			"SUMMARY:"+escapeText(e.Summary),
+			"DESCRIPTION:"+escapeText(e.Description),
+			"STATUS:"+status,
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapeText escapes TEXT values as required by RFC 5545 §3.3.11
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// foldLine splits a content line into 75-octet chunks, never inside a UTF-8 sequence
+func foldLine(line string) string {
+	if len(line) <= maxLineOctets {
+		return line
+	}
+
+	var folded strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		// Back up to the start of a UTF-8 sequence
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		folded.WriteString(line[:cut])
+		folded.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts toward the limit
+		limit = maxLineOctets - 1
+	}
+	folded.WriteString(line)
+	return folded.String()
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
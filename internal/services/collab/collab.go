@@ -0,0 +1,400 @@
+package collab
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/pubsub"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "CollabHub"),
+)
+
+// Message types sent to clients
+const (
+	TypeSubscribed   = "subscribed"
+	TypeUnsubscribed = "unsubscribed"
+	TypeUpdate       = "update"
+	TypePresence     = "presence"
+	TypeError        = "error"
+	TypePong         = "pong"
+)
+
+// Reasons a subscription ends without the client asking
+const (
+	ReasonAccessRevoked  = "access revoked"
+	ReasonProjectDeleted = "project deleted"
+)
+
+const (
+	// MaxSubscriptions caps the projects one connection may follow
+	MaxSubscriptions = 50
+	sendBuffer       = 64
+	restartDelay     = 5 * time.Second
+)
+
+// Message is what clients receive
+type Message struct {
+	Type      string          `json:"type"`
+	ProjectID uint            `json:"projectId,omitempty"`
+	Event     *db.ChangeEvent `json:"event,omitempty"`  // For update
+	Users     []string        `json:"users,omitempty"`  // For presence: users viewing the project
+	Reason    string          `json:"reason,omitempty"` // For unsubscribed
+	Error     string          `json:"error,omitempty"`
+}
+
+// Client is one connection. The hub queues its messages; if the connection
+// falls behind, the hub gives up on it and closes Done.
+type Client struct {
+	ID       string
+	UserID   string
+	send     chan Message
+	done     chan struct{}
+	once     sync.Once
+	projects map[uint]bool // Guarded by Hub.mu
+}
+
+func (c *Client) Send() <-chan Message {
+	return c.send
+}
+
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *Client) close() {
+	c.once.Do(func() { close(c.done) })
+}
+
+// Hub relays project changes and presence to the clients connected to this
+// replica. Changes come from the event log and presence from the database,
+// and both are announced to every replica through PostgreSQL LISTEN/NOTIFY.
+type Hub struct {
+	database     *pgconnect.DB
+	eventService *events.EventService
+	pubsub       *pubsub.PubSub
+	access       events.AccessChecker
+	presenceRepo *pgconnect.Repository[db.ProjectPresence]
+	replicaID    string
+	nextID       atomic.Int64
+
+	mu       sync.Mutex
+	clients  map[*Client]bool
+	projects map[uint]map[*Client]bool // Subscribers per project
+
+	presenceMu      sync.Mutex
+	presencePending map[uint]bool // Projects whose presence must be resent
+	presenceSignal  chan struct{}
+}
+
+func NewHub(database *pgconnect.DB, eventService *events.EventService, ps *pubsub.PubSub, access events.AccessChecker) (*Hub, error) {
+	replicaID := make([]byte, 8)
+	if _, err := rand.Read(replicaID); err != nil {
+		return nil, err
+	}
+
+	h := &Hub{
+		database:        database,
+		eventService:    eventService,
+		pubsub:          ps,
+		access:          access,
+		presenceRepo:    pgconnect.NewRepository[db.ProjectPresence](database),
+		replicaID:       hex.EncodeToString(replicaID),
+		clients:         make(map[*Client]bool),
+		projects:        make(map[uint]map[*Client]bool),
+		presencePending: make(map[uint]bool),
+		presenceSignal:  make(chan struct{}, 1),
+	}
+
+	ps.Subscribe(PresenceChannel, func(payload string) {
+		if projectID, err := strconv.ParseUint(payload, 10, 32); err == nil {
+			h.markPresenceChanged(uint(projectID))
+		}
+	})
+	// Presence changes announced while disconnected were missed
+	ps.OnReconnect(func() {
+		h.markPresenceChanged(h.subscribedProjects()...)
+	})
+
+	return h, nil
+}
+
+// Start relays changes and maintains presence until ctx ends
+func (h *Hub) Start(ctx context.Context) {
+	go h.runEvents(ctx)
+	go h.runPresence(ctx)
+	go h.runSweep(ctx)
+}
+
+func (h *Hub) Connect(userID string) *Client {
+	client := &Client{
+		ID:       h.replicaID + "-" + strconv.FormatInt(h.nextID.Add(1), 10),
+		UserID:   userID,
+		send:     make(chan Message, sendBuffer),
+		done:     make(chan struct{}),
+		projects: make(map[uint]bool),
+	}
+
+	h.mu.Lock()
+	h.clients[client] = true
+	h.mu.Unlock()
+
+	return client
+}
+
+// Disconnect drops every subscription of the client and its presence
+func (h *Hub) Disconnect(client *Client) {
+	h.mu.Lock()
+	projectIDs := make([]uint, 0, len(client.projects))
+	for projectID := range client.projects {
+		projectIDs = append(projectIDs, projectID)
+		h.removeSubscriber(client, projectID)
+	}
+	delete(h.clients, client)
+	h.mu.Unlock()
+
+	client.close()
+
+	if len(projectIDs) > 0 {
+		if err := h.presenceRepo.DeleteWhere("connection_id = ?", client.ID); err != nil {
+			log.Error("presence:failed", "connectionID", client.ID, "error", err)
+		}
+		h.publishPresence(projectIDs...)
+	}
+}
+
+// Subscribe starts relaying a project's changes and presence to the client
+func (h *Hub) Subscribe(client *Client, projectID uint) error {
+	canAccess, err := h.access.CanAccessProject(client.UserID, projectID)
+	if err != nil {
+		return err
+	}
+	if !canAccess {
+		return errors.New("user cannot access this project")
+	}
+
+	h.mu.Lock()
+	if !client.projects[projectID] {
+		if len(client.projects) >= MaxSubscriptions {
+			h.mu.Unlock()
+			return errors.New("subscription limit reached")
+		}
+		client.projects[projectID] = true
+		if h.projects[projectID] == nil {
+			h.projects[projectID] = make(map[*Client]bool)
+		}
+		h.projects[projectID][client] = true
+	}
+	h.mu.Unlock()
+
+	if err := h.touchPresence(client, projectID); err != nil {
+		log.Error("presence:failed", "connectionID", client.ID, "error", err)
+	}
+	h.Send(client, Message{Type: TypeSubscribed, ProjectID: projectID})
+	h.publishPresence(projectID)
+	return nil
+}
+
+func (h *Hub) Unsubscribe(client *Client, projectID uint) {
+	h.endSubscription(client, projectID, "")
+}
+
+// Send queues a message for the client. A client whose queue is full is
+// disconnected rather than allowed to hold up everyone else.
+func (h *Hub) Send(client *Client, message Message) {
+	select {
+	case client.send <- message:
+	case <-client.done:
+	default:
+		log.Warn("client:too-slow", "connectionID", client.ID, "userID", client.UserID)
+		client.close()
+	}
+}
+
+// Private helper methods
+
+// runEvents relays the event log, restarting after database errors
+func (h *Hub) runEvents(ctx context.Context) {
+	var cursor int64
+	for {
+		err := func() error {
+			if cursor == 0 {
+				latestID, err := h.eventService.LatestID()
+				if err != nil {
+					return err
+				}
+				cursor = latestID
+			}
+			return h.eventService.Stream(ctx, cursor, nil,
+				func(event *db.ChangeEvent) error {
+					h.dispatch(event)
+					cursor = event.ID
+					return nil
+				},
+				func() error { return nil },
+			)
+		}()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error("events:failed", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(restartDelay):
+		}
+	}
+}
+
+// dispatch sends an event to the project's subscribers, then re-checks the
+// access of everyone the change may have locked out
+func (h *Hub) dispatch(event *db.ChangeEvent) {
+	if event.EntityType == events.EntityProject && event.ProjectID != nil {
+		projectID := *event.ProjectID
+		subscribers := h.subscribers(projectID)
+		for _, client := range subscribers {
+			h.Send(client, Message{Type: TypeUpdate, ProjectID: projectID, Event: event})
+		}
+
+		switch event.Action {
+		case events.ActionDeleted:
+			for _, client := range subscribers {
+				h.endSubscription(client, projectID, ReasonProjectDeleted)
+			}
+		case events.ActionMemberRemoved, events.ActionMemberUpdated, events.ActionTeamRemoved, events.ActionMoved, events.ActionOwnerChanged:
+			// Access is inherited down the project tree, so sub-projects may be affected too
+			h.recheckTree(projectID)
+		}
+		return
+	}
+
+	// Company and team membership grant access to the company's projects
+	if event.EntityType == events.EntityCompany {
+		switch event.Action {
+		case events.ActionMemberRemoved, events.ActionMemberUpdated, events.ActionTeamMemberRemoved:
+			h.recheckUser(event.UserID)
+		case events.ActionDeleted:
+			h.recheckUser("")
+		}
+	}
+}
+
+// recheckUser re-checks every subscription of the user, or of all users when userID is empty
+func (h *Hub) recheckUser(userID string) {
+	type subscription struct {
+		client    *Client
+		projectID uint
+	}
+
+	h.mu.Lock()
+	var subscriptions []subscription
+	for client := range h.clients {
+		if userID != "" && client.UserID != userID {
+			continue
+		}
+		for projectID := range client.projects {
+			subscriptions = append(subscriptions, subscription{client, projectID})
+		}
+	}
+	h.mu.Unlock()
+
+	for _, s := range subscriptions {
+		h.recheck(s.client, s.projectID)
+	}
+}
+
+// recheckTree re-checks the subscriptions of a project and of its sub-projects
+func (h *Hub) recheckTree(projectID uint) {
+	projectIDs := []uint{projectID}
+	var descendants []uint
+	err := h.database.Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT id FROM base_projects WHERE parent_id = ?
+			UNION
+			SELECT child.id FROM base_projects child JOIN tree ON child.parent_id = tree.id
+		)
+		SELECT id FROM tree`, projectID).Scan(&descendants).Error
+	if err != nil {
+		// The sweep re-checks every subscription later
+		log.Error("recheck:failed", "projectID", projectID, "error", err)
+	}
+	projectIDs = append(projectIDs, descendants...)
+
+	for _, id := range projectIDs {
+		for _, client := range h.subscribers(id) {
+			h.recheck(client, id)
+		}
+	}
+}
+
+func (h *Hub) recheck(client *Client, projectID uint) {
+	canAccess, err := h.access.CanAccessProject(client.UserID, projectID)
+	if err != nil {
+		// Keep the subscription; the next check decides
+		log.Error("recheck:failed", "projectID", projectID, "error", err)
+		return
+	}
+	if !canAccess {
+		h.endSubscription(client, projectID, ReasonAccessRevoked)
+	}
+}
+
+func (h *Hub) endSubscription(client *Client, projectID uint, reason string) {
+	h.mu.Lock()
+	if !client.projects[projectID] {
+		h.mu.Unlock()
+		return
+	}
+	h.removeSubscriber(client, projectID)
+	h.mu.Unlock()
+
+	if err := h.presenceRepo.DeleteWhere("connection_id = ? AND project_id = ?", client.ID, projectID); err != nil {
+		log.Error("presence:failed", "connectionID", client.ID, "error", err)
+	}
+	h.Send(client, Message{Type: TypeUnsubscribed, ProjectID: projectID, Reason: reason})
+	h.publishPresence(projectID)
+}
+
+// removeSubscriber must be called with h.mu held
+func (h *Hub) removeSubscriber(client *Client, projectID uint) {
+	delete(client.projects, projectID)
+	delete(h.projects[projectID], client)
+	if len(h.projects[projectID]) == 0 {
+		delete(h.projects, projectID)
+	}
+}
+
+func (h *Hub) subscribers(projectID uint) []*Client {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	clients := make([]*Client, 0, len(h.projects[projectID]))
+	for client := range h.projects[projectID] {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+func (h *Hub) subscribedProjects() []uint {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	projectIDs := make([]uint, 0, len(h.projects))
+	for projectID := range h.projects {
+		projectIDs = append(projectIDs, projectID)
+	}
+	return projectIDs
+}
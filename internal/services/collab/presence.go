@@ -0,0 +1,136 @@
+package collab
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"gorm.io/gorm/clause"
+)
+
+// PresenceChannel announces the ID of a project whose viewers changed
+const PresenceChannel = "project_core_presence"
+
+const (
+	// sweepInterval is how often a replica refreshes its presence rows,
+	// expires those of dead replicas and re-checks subscription access
+	sweepInterval = 30 * time.Second
+	presenceTTL   = 3 * sweepInterval
+)
+
+// touchPresence records that the client is viewing the project
+func (h *Hub) touchPresence(client *Client, projectID uint) error {
+	return h.database.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "connection_id"}, {Name: "project_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"seen_at"}),
+	}).Create(&db.ProjectPresence{
+		ConnectionID: client.ID,
+		ProjectID:    projectID,
+		UserID:       client.UserID,
+		SeenAt:       time.Now(),
+	}).Error
+}
+
+// publishPresence tells every replica that the projects' viewers changed
+func (h *Hub) publishPresence(projectIDs ...uint) {
+	for _, projectID := range projectIDs {
+		if err := h.pubsub.Publish(context.Background(), PresenceChannel, strconv.FormatUint(uint64(projectID), 10)); err != nil {
+			log.Error("presence:publish-failed", "projectID", projectID, "error", err)
+		}
+	}
+}
+
+// markPresenceChanged queues the projects for runPresence. Changes are
+// coalesced, so a burst of joins sends one presence message.
+func (h *Hub) markPresenceChanged(projectIDs ...uint) {
+	h.presenceMu.Lock()
+	for _, projectID := range projectIDs {
+		h.presencePending[projectID] = true
+	}
+	h.presenceMu.Unlock()
+
+	select {
+	case h.presenceSignal <- struct{}{}:
+	default:
+	}
+}
+
+// runPresence sends the current viewers of changed projects to their local subscribers
+func (h *Hub) runPresence(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.presenceSignal:
+		}
+
+		h.presenceMu.Lock()
+		pending := h.presencePending
+		h.presencePending = make(map[uint]bool)
+		h.presenceMu.Unlock()
+
+		for projectID := range pending {
+			subscribers := h.subscribers(projectID)
+			if len(subscribers) == 0 {
+				continue
+			}
+			users, err := h.viewers(projectID)
+			if err != nil {
+				log.Error("presence:failed", "projectID", projectID, "error", err)
+				continue
+			}
+			for _, client := range subscribers {
+				h.Send(client, Message{Type: TypePresence, ProjectID: projectID, Users: users})
+			}
+		}
+	}
+}
+
+// viewers lists the users with a live connection to the project, on any replica
+func (h *Hub) viewers(projectID uint) ([]string, error) {
+	users := []string{}
+	err := h.database.Model(&db.ProjectPresence{}).
+		Where("project_id = ? AND seen_at > ?", projectID, time.Now().Add(-presenceTTL)).
+		Distinct("user_id").Order("user_id").Pluck("user_id", &users).Error
+	return users, err
+}
+
+func (h *Hub) runSweep(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Keep this replica's connections alive
+		if err := h.database.Model(&db.ProjectPresence{}).
+			Where("connection_id LIKE ?", h.replicaID+"-%").
+			Update("seen_at", time.Now()).Error; err != nil {
+			log.Error("sweep:failed", "error", err)
+		}
+
+		// Connections of replicas that stopped refreshing are gone
+		var expired []db.ProjectPresence
+		if err := h.database.Clauses(clause.Returning{Columns: []clause.Column{{Name: "project_id"}}}).
+			Where("seen_at < ?", time.Now().Add(-presenceTTL)).
+			Delete(&expired).Error; err != nil {
+			log.Error("sweep:failed", "error", err)
+		}
+		changed := make(map[uint]bool)
+		for _, presence := range expired {
+			if !changed[presence.ProjectID] {
+				changed[presence.ProjectID] = true
+				h.publishPresence(presence.ProjectID)
+			}
+		}
+
+		// Events cover the changes that revoke access; this catches any the
+		// hub missed, such as those recorded while its stream was restarting
+		h.recheckUser("")
+	}
+}
@@ -2,21 +2,44 @@ package companies
 
 import (
 	"errors"
+	"log/slog"
 	"time"
 
 	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
 	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "CompanyService"),
 )
 
 type CompanyService struct {
-	companyRepo       *pgconnect.Repository[db.Company]
-	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+	database            *pgconnect.DB
+	templates           *TemplateRegistry
+	notificationService *notifications.NotificationService
+	eventService        *events.EventService
+	companyRepo         *pgconnect.Repository[db.Company]
+	companyMemberRepo   *pgconnect.Repository[db.CompanyMember]
+	teamRepo            *pgconnect.Repository[db.Team]
+	teamMemberRepo      *pgconnect.Repository[db.TeamMember]
+	roleRepo            *pgconnect.Repository[db.CompanyRole]
 }
 
-func NewCompanyService(database *pgconnect.DB) *CompanyService {
+func NewCompanyService(database *pgconnect.DB, templates *TemplateRegistry, notificationService *notifications.NotificationService, eventService *events.EventService) *CompanyService {
 	return &CompanyService{
-		companyRepo:       pgconnect.NewRepository[db.Company](database),
-		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+		database:            database,
+		templates:           templates,
+		notificationService: notificationService,
+		eventService:        eventService,
+		companyRepo:         pgconnect.NewRepository[db.Company](database),
+		companyMemberRepo:   pgconnect.NewRepository[db.CompanyMember](database),
+		teamRepo:            pgconnect.NewRepository[db.Team](database),
+		teamMemberRepo:      pgconnect.NewRepository[db.TeamMember](database),
+		roleRepo:            pgconnect.NewRepository[db.CompanyRole](database),
 	}
 }
 
@@ -26,31 +49,107 @@ func (s *CompanyService) CreateCompany(company *db.Company) (*db.Company, error)
 		return nil, errors.New("company ID is required")
 	}
 
-	// Save to database
-	if err := s.companyRepo.Create(company); err != nil {
+	template, hasTemplate := s.templates.Get(company.Type)
+
+	// The company, its owner and its template data are created in one transaction
+	err := s.database.WithTransaction(func(tx *gorm.DB) error {
+		if err := tx.Create(company).Error; err != nil {
+			return err
+		}
+
+		// Add the owner as a company member with admin role
+		ownerMember := &db.CompanyMember{
+			CompanyID: company.ID,
+			UserID:    company.OwnerID,
+			Role:      OwnerRole,
+			Status:    "active",
+			JoinedAt:  &time.Time{},
+			InvitedAt: time.Now(),
+			InvitedBy: company.OwnerID,
+		}
+		now := time.Now()
+		ownerMember.JoinedAt = &now
+
+		if err := tx.Create(ownerMember).Error; err != nil {
+			return err
+		}
+
+		if hasTemplate {
+			return applyCompanyTemplate(tx, company, template)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Add the owner as a company member with admin role
-	ownerMember := &db.CompanyMember{
-		CompanyID: company.ID,
-		UserID:    company.OwnerID,
-		Role:      "owner",
-		Status:    "active",
-		JoinedAt:  &time.Time{},
-		InvitedAt: time.Now(),
-		InvitedBy: company.OwnerID,
+	s.recordEvent(events.CompanyEvent(events.ActionCreated, company, company.OwnerID))
+
+	return company, nil
+}
+
+// applyCompanyTemplate seeds the roles and starter projects of a new company
+func applyCompanyTemplate(tx *gorm.DB, company *db.Company, template CompanyTemplate) error {
+	for _, roleTemplate := range template.Roles {
+		role := &db.CompanyRole{
+			CompanyID:   company.ID,
+			Name:        roleTemplate.Name,
+			Permissions: roleTemplate.Permissions,
+		}
+		if err := tx.Create(role).Error; err != nil {
+			return err
+		}
 	}
+
 	now := time.Now()
-	ownerMember.JoinedAt = &now
+	for _, starter := range template.StarterProjects {
+		project := &db.BaseProject{
+			Title:       starter.Title,
+			Description: starter.Description,
+			Status:      "active",
+			OwnerID:     company.OwnerID,
+			CompanyID:   &company.ID,
+			StartDate:   &now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if starter.DurationDays != nil {
+			endDate := now.AddDate(0, 0, *starter.DurationDays)
+			project.EndDate = &endDate
+		}
+		if err := tx.Create(project).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-	if err := s.companyMemberRepo.Create(ownerMember); err != nil {
-		// Rollback company creation if member creation fails
-		s.companyRepo.Delete(company)
+func (s *CompanyService) GetCompanyRoles(companyID, userID string) ([]db.CompanyRole, error) {
+	canAccess, err := s.userCanAccessCompany(userID, companyID)
+	if err != nil {
 		return nil, err
 	}
+	if !canAccess {
+		return nil, errors.New("user cannot access this company")
+	}
 
-	return company, nil
+	// Companies without seeded roles list the default roles
+	var roles []db.CompanyRole
+	if err := s.roleRepo.FindWhere(&roles, "company_id = ?", companyID); err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		for _, role := range DefaultRoles {
+			roles = append(roles, db.CompanyRole{CompanyID: companyID, Name: role.Name, Permissions: role.Permissions})
+		}
+	}
+
+	return roles, nil
+}
+
+func (s *CompanyService) GetCompanyTemplates() []CompanyTemplate {
+	return s.templates.List()
 }
 
 func (s *CompanyService) GetCompany(id string, userID string) (*db.Company, error) {
@@ -99,6 +198,8 @@ func (s *CompanyService) UpdateCompany(id string, updates *db.Company, userID st
 		return nil, err
 	}
 
+	s.recordEvent(events.CompanyEvent(events.ActionUpdated, &company, userID))
+
 	return &company, nil
 }
 
@@ -113,13 +214,68 @@ func (s *CompanyService) DeleteCompany(id string, userID string) error {
 		return errors.New("only company owner can delete company")
 	}
 
-	// Delete all company members first
-	if err := s.companyMemberRepo.DeleteWhere("company_id = ?", id); err != nil {
+	// The company and everything it holds go together
+	err := s.database.WithTransaction(func(tx *gorm.DB) error {
+		// Delete company teams with their grants and memberships
+		companyTeams := tx.Model(&db.Team{}).Select("id").Where("company_id = ?", id)
+		if err := tx.Where("team_id IN (?)", companyTeams).Delete(&db.ProjectTeam{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("team_id IN (?)", companyTeams).Delete(&db.TeamMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("company_id = ?", id).Delete(&db.Team{}).Error; err != nil {
+			return err
+		}
+
+		// Delete company roles
+		if err := tx.Where("company_id = ?", id).Delete(&db.CompanyRole{}).Error; err != nil {
+			return err
+		}
+
+		// Delete company project templates
+		companyTemplates := tx.Model(&db.ProjectTemplate{}).Select("id").Where("company_id = ?", id)
+		if err := tx.Where("template_id IN (?)", companyTemplates).Delete(&db.ProjectTemplateMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("company_id = ?", id).Delete(&db.ProjectTemplate{}).Error; err != nil {
+			return err
+		}
+
+		// Delete company custom field definitions
+		if err := tx.Where("company_id = ?", id).Delete(&db.CustomFieldDefinition{}).Error; err != nil {
+			return err
+		}
+
+		// Delete company tags and their uses
+		companyTags := tx.Model(&db.Tag{}).Select("id").Where("company_id = ?", id)
+		if err := tx.Where("tag_id IN (?)", companyTags).Delete(&db.ProjectTag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("company_id = ?", id).Delete(&db.Tag{}).Error; err != nil {
+			return err
+		}
+
+		// Delete compensation history
+		if err := tx.Where("company_id = ?", id).Delete(&db.CompensationRate{}).Error; err != nil {
+			return err
+		}
+
+		// Delete all company members
+		if err := tx.Where("company_id = ?", id).Delete(&db.CompanyMember{}).Error; err != nil {
+			return err
+		}
+
+		// Delete company
+		return tx.Delete(&company).Error
+	})
+	if err != nil {
 		return err
 	}
 
-	// Delete company
-	return s.companyRepo.Delete(&company)
+	s.recordEvent(events.CompanyEvent(events.ActionDeleted, &company, userID))
+
+	return nil
 }
 
 func (s *CompanyService) GetUserCompanies(userID string) ([]db.Company, error) {
@@ -155,10 +311,25 @@ func (s *CompanyService) GetCompanyMembers(companyID string, requestingUserID st
 		return nil, err
 	}
 
+	// Compensation is only visible to finance/admin roles, and to each member for themselves
+	if s.userCanManageCompensation(requestingUserID, companyID) {
+		if err := s.applyCurrentRates(companyID, members); err != nil {
+			return nil, err
+		}
+	} else {
+		for i := range members {
+			if members[i].UserID != requestingUserID {
+				redactCompensation(&members[i])
+			}
+		}
+	}
+
 	return members, nil
 }
 
-func (s *CompanyService) AddCompanyMember(companyID, userID, role string, requestingUserID string) (*db.CompanyMember, error) {
+// AddCompanyMember adds an active member. compensation is optional and
+// requires the finance or admin permission.
+func (s *CompanyService) AddCompanyMember(companyID, userID, role string, compensation *Compensation, requestingUserID string) (*db.CompanyMember, error) {
 	// Check if requesting user can add members
 	canManage, err := s.userCanManageCompanyMembers(requestingUserID, companyID)
 	if err != nil {
@@ -168,6 +339,13 @@ func (s *CompanyService) AddCompanyMember(companyID, userID, role string, reques
 		return nil, errors.New("user cannot add members to this company")
 	}
 
+	if err := ValidateRole(s.database, companyID, role); err != nil {
+		return nil, err
+	}
+	if err := s.checkCanAssignRole(companyID, role, requestingUserID); err != nil {
+		return nil, err
+	}
+
 	// Check if user is already a member
 	var existing db.CompanyMember
 	err = s.companyMemberRepo.FindOne(&existing, "company_id = ? AND user_id = ?", companyID, userID)
@@ -186,13 +364,91 @@ func (s *CompanyService) AddCompanyMember(companyID, userID, role string, reques
 	now := time.Now()
 	member.JoinedAt = &now
 
-	if err := s.companyMemberRepo.Create(member); err != nil {
+	if compensation == nil {
+		if err := s.companyMemberRepo.Create(member); err != nil {
+			return nil, err
+		}
+		s.recordEvent(events.CompanyMemberEvent(events.ActionMemberAdded, member, requestingUserID))
+		s.notifyCompanyMember(companyID, userID, notifications.CompanyMemberAdded)
+		return member, nil
+	}
+
+	if !s.userCanManageCompensation(requestingUserID, companyID) {
+		return nil, errors.New("user cannot manage compensation for this company")
+	}
+
+	// The member and its first rate are saved together
+	err = s.database.WithTransaction(func(tx *gorm.DB) error {
+		if err := tx.Create(member).Error; err != nil {
+			return err
+		}
+		_, err := setCompensation(tx, member, *compensation, requestingUserID)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	s.recordEvent(events.CompanyMemberEvent(events.ActionMemberAdded, member, requestingUserID))
+	s.notifyCompanyMember(companyID, userID, notifications.CompanyMemberAdded)
+
 	return member, nil
 }
 
+// UpdateCompanyMemberRole changes the role of an active or invited member.
+// Members cannot change their own role, and only the owner and admins can
+// grant or revoke a role carrying the admin permission.
+func (s *CompanyService) UpdateCompanyMemberRole(companyID, userID, role string, requestingUserID string) (*db.CompanyMember, error) {
+	canManage, err := s.userCanManageCompanyMembers(requestingUserID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("user cannot update members of this company")
+	}
+	if userID == requestingUserID {
+		return nil, errors.New("cannot change your own role")
+	}
+
+	if err := ValidateRole(s.database, companyID, role); err != nil {
+		return nil, err
+	}
+
+	var company db.Company
+	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
+		return nil, err
+	}
+	if company.OwnerID == userID {
+		return nil, errors.New("cannot change company owner role")
+	}
+
+	var member db.CompanyMember
+	if err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ?", companyID, userID); err != nil {
+		return nil, errors.New("user is not a member of this company")
+	}
+	if member.Role == role {
+		s.redactFor(&member, requestingUserID)
+		return &member, nil
+	}
+	if err := s.checkCanAssignRole(companyID, role, requestingUserID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCanAssignRole(companyID, member.Role, requestingUserID); err != nil {
+		return nil, err
+	}
+
+	member.Role = role
+	if err := s.companyMemberRepo.Update(&member); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(events.CompanyMemberEvent(events.ActionMemberUpdated, &member, requestingUserID))
+	s.notifyCompanyMember(companyID, userID, notifications.CompanyRoleChanged)
+
+	s.redactFor(&member, requestingUserID)
+	return &member, nil
+}
+
 func (s *CompanyService) RemoveCompanyMember(companyID, userID string, requestingUserID string) error {
 	// Check permissions
 	canManage, err := s.userCanManageCompanyMembers(requestingUserID, companyID)
@@ -215,52 +471,132 @@ func (s *CompanyService) RemoveCompanyMember(companyID, userID string, requestin
 		return errors.New("cannot remove company owner")
 	}
 
+	// Remove member from the company's teams, which also drops team-based project access
+	var teams []db.Team
+	if err := s.teamRepo.FindWhere(&teams, "company_id = ?", companyID); err != nil {
+		return err
+	}
+	if len(teams) > 0 {
+		teamIDs := make([]uint, len(teams))
+		for i, team := range teams {
+			teamIDs[i] = team.ID
+		}
+		if err := s.teamMemberRepo.DeleteWhere("team_id IN ? AND user_id = ?", teamIDs, userID); err != nil {
+			return err
+		}
+	}
+
+	var member db.CompanyMember
+	err = s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ?", companyID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	wasMember := err == nil
+
 	// Remove member
-	return s.companyMemberRepo.DeleteWhere("company_id = ? AND user_id = ?", companyID, userID)
+	if err := s.companyMemberRepo.DeleteWhere("company_id = ? AND user_id = ?", companyID, userID); err != nil {
+		return err
+	}
+
+	if wasMember {
+		s.recordEvent(events.CompanyMemberEvent(events.ActionMemberRemoved, &member, requestingUserID))
+	}
+
+	return nil
+}
+
+// CanAccessCompany reports whether the user is an active member of the company
+func (s *CompanyService) CanAccessCompany(userID, companyID string) (bool, error) {
+	return s.userCanAccessCompany(userID, companyID)
+}
+
+// CanUpdateCompany reports whether the user can change the company. Missing
+// companies are not allowed.
+func (s *CompanyService) CanUpdateCompany(userID, companyID string) (bool, error) {
+	return allowIfFound(s.userCanUpdateCompany(userID, companyID))
+}
+
+// CanManageCompanyMembers reports whether the user can add, update and remove
+// the company's members. Missing companies are not allowed.
+func (s *CompanyService) CanManageCompanyMembers(userID, companyID string) (bool, error) {
+	return allowIfFound(s.userCanManageCompanyMembers(userID, companyID))
 }
 
 // Private helper methods
 
+// allowIfFound turns the not found error of a permission check into a denial
+func allowIfFound(allowed bool, err error) (bool, error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return allowed, err
+}
+
 func (s *CompanyService) userCanAccessCompany(userID, companyID string) (bool, error) {
 	var member db.CompanyMember
 	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
 	return err == nil, nil
 }
 
+// userCanUpdateCompany allows the owner and roles with the admin permission
 func (s *CompanyService) userCanUpdateCompany(userID, companyID string) (bool, error) {
-	var company db.Company
-	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
-		return false, err
-	}
+	return HasPermission(s.database, companyID, userID, AdminPermission)
+}
 
-	// Owner can always update
-	if company.OwnerID == userID {
-		return true, nil
-	}
+// userCanManageCompanyMembers allows the owner and roles with the
+// manage_members permission
+func (s *CompanyService) userCanManageCompanyMembers(userID, companyID string) (bool, error) {
+	return HasPermission(s.database, companyID, userID, ManageMembersPermission)
+}
 
-	// Check if user is admin
-	var member db.CompanyMember
-	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND role = ? AND status = ?", companyID, userID, "admin", "active")
-	return err == nil, nil
+// checkCanAssignRole keeps roles carrying the admin permission in the hands of
+// the owner and admins
+func (s *CompanyService) checkCanAssignRole(companyID, role, requestingUserID string) error {
+	isAdminRole, err := RoleGrants(s.database, companyID, role, AdminPermission)
+	if err != nil || !isAdminRole {
+		return err
+	}
+	isAdmin, err := HasPermission(s.database, companyID, requestingUserID, AdminPermission)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return errors.New("only owners and admins can grant or revoke admin")
+	}
+	return nil
 }
 
-func (s *CompanyService) userCanManageCompanyMembers(userID, companyID string) (bool, error) {
-	var company db.Company
-	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
-		return false, err
+// recordEvent adds a change to the event stream. It runs after the change
+// was saved and only logs failures.
+func (s *CompanyService) recordEvent(event db.ChangeEvent) {
+	if s.eventService == nil {
+		return
 	}
+	if err := s.eventService.Record(&event); err != nil {
+		log.Error("record-event:failed", "action", event.Action, "error", err)
+	}
+}
 
-	// Owner can always manage
-	if company.OwnerID == userID {
-		return true, nil
+// notifyCompanyMember delivers an in-app notification about the user's
+// membership. Delivery is best effort and failures are only logged.
+func (s *CompanyService) notifyCompanyMember(companyID, userID string, build func(company *db.Company, member *db.CompanyMember) db.Notification) {
+	if s.notificationService == nil {
+		return
 	}
 
-	// Check if user is admin or manager
+	var company db.Company
 	var member db.CompanyMember
-	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
-	if err != nil {
-		return false, nil
+	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
+		log.Error("notify:failed", "companyID", companyID, "error", err)
+		return
+	}
+	if err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ?", companyID, userID); err != nil {
+		log.Error("notify:failed", "companyID", companyID, "error", err)
+		return
 	}
 
-	return member.Role == "admin" || member.Role == "manager", nil
+	notification := build(&company, &member)
+	if _, err := s.notificationService.Notify([]string{userID}, notification); err != nil {
+		log.Error("notify:failed", "type", notification.Type, "error", err)
+	}
 }
@@ -0,0 +1,188 @@
+package companies
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"gorm.io/gorm"
+)
+
+const (
+	// FinancePermission lets a company role view and change member compensation
+	FinancePermission = "finance"
+	// DefaultCurrency applies when neither the request nor the member names a currency
+	DefaultCurrency = "USD"
+)
+
+// Compensation is a requested rate change. EffectiveFrom defaults to now; a
+// future date records the change without touching the current rate yet.
+type Compensation struct {
+	Salary        *float64
+	HourlyRate    *float64
+	Currency      string
+	EffectiveFrom time.Time
+}
+
+func (s *CompanyService) SetMemberCompensation(companyID, userID string, compensation Compensation, requestingUserID string) (*db.CompensationRate, error) {
+	if !s.userCanManageCompensation(requestingUserID, companyID) {
+		return nil, errors.New("user cannot manage compensation for this company")
+	}
+
+	var member db.CompanyMember
+	if err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ?", companyID, userID); err != nil {
+		return nil, errors.New("user is not a member of this company")
+	}
+
+	var rate *db.CompensationRate
+	err := s.database.WithTransaction(func(tx *gorm.DB) error {
+		var err error
+		rate, err = setCompensation(tx, &member, compensation, requestingUserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (s *CompanyService) GetMemberCompensationHistory(companyID, userID, requestingUserID string) ([]db.CompensationRate, error) {
+	// Members can always see their own history
+	if userID != requestingUserID && !s.userCanManageCompensation(requestingUserID, companyID) {
+		return nil, errors.New("user cannot view compensation for this company")
+	}
+
+	var rates []db.CompensationRate
+	if err := s.database.Where("company_id = ? AND user_id = ?", companyID, userID).Order("effective_from DESC, id DESC").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// Private helper methods
+
+// setCompensation records a rate in the member's history and refreshes the
+// member's current rate from it
+func setCompensation(tx *gorm.DB, member *db.CompanyMember, compensation Compensation, setBy string) (*db.CompensationRate, error) {
+	if compensation.Salary == nil && compensation.HourlyRate == nil {
+		return nil, errors.New("salary or hourly rate is required")
+	}
+	if (compensation.Salary != nil && *compensation.Salary < 0) || (compensation.HourlyRate != nil && *compensation.HourlyRate < 0) {
+		return nil, errors.New("compensation cannot be negative")
+	}
+
+	currency := strings.ToUpper(compensation.Currency)
+	if currency == "" {
+		currency = member.Currency
+	}
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if !validCurrency(currency) {
+		return nil, errors.New("invalid currency code")
+	}
+
+	now := time.Now()
+	effectiveFrom := compensation.EffectiveFrom
+	if effectiveFrom.IsZero() {
+		effectiveFrom = now
+	}
+
+	rate := &db.CompensationRate{
+		CompanyID:     member.CompanyID,
+		UserID:        member.UserID,
+		Salary:        compensation.Salary,
+		HourlyRate:    compensation.HourlyRate,
+		Currency:      currency,
+		EffectiveFrom: effectiveFrom,
+		SetBy:         setBy,
+		CreatedAt:     now,
+	}
+	if err := tx.Create(rate).Error; err != nil {
+		return nil, err
+	}
+
+	// A back-dated rate may be older than the one currently in effect
+	var current db.CompensationRate
+	err := tx.Where("company_id = ? AND user_id = ? AND effective_from <= ?", member.CompanyID, member.UserID, now).
+		Order("effective_from DESC, id DESC").
+		First(&current).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return rate, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	member.Salary = current.Salary
+	member.HourlyRate = current.HourlyRate
+	member.Currency = current.Currency
+	if err := tx.Model(member).Updates(map[string]interface{}{
+		"salary":      current.Salary,
+		"hourly_rate": current.HourlyRate,
+		"currency":    current.Currency,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+// applyCurrentRates replaces the stored rate of each member with the latest
+// rate in effect now, so future-dated changes show once they start
+func (s *CompanyService) applyCurrentRates(companyID string, members []db.CompanyMember) error {
+	var rates []db.CompensationRate
+	if err := s.database.Where("company_id = ? AND effective_from <= ?", companyID, time.Now()).Order("effective_from, id").Find(&rates).Error; err != nil {
+		return err
+	}
+
+	current := make(map[string]db.CompensationRate, len(rates))
+	for _, rate := range rates {
+		current[rate.UserID] = rate
+	}
+
+	for i := range members {
+		if rate, ok := current[members[i].UserID]; ok {
+			members[i].Salary = rate.Salary
+			members[i].HourlyRate = rate.HourlyRate
+			members[i].Currency = rate.Currency
+		}
+	}
+	return nil
+}
+
+// redactFor hides the member's compensation unless the caller is the member or
+// can manage compensation
+func (s *CompanyService) redactFor(member *db.CompanyMember, requestingUserID string) {
+	if member.UserID != requestingUserID && !s.userCanManageCompensation(requestingUserID, member.CompanyID) {
+		redactCompensation(member)
+	}
+}
+
+func redactCompensation(member *db.CompanyMember) {
+	member.Salary = nil
+	member.HourlyRate = nil
+	member.Currency = ""
+}
+
+func validCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// userCanManageCompensation allows the owner, admins and members whose company
+// role carries the finance or admin permission
+func (s *CompanyService) userCanManageCompensation(userID, companyID string) bool {
+	allowed, err := HasPermission(s.database, companyID, userID, FinancePermission)
+	return err == nil && allowed
+}
@@ -0,0 +1,82 @@
+package companies
+
+import (
+	"errors"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+)
+
+// InviteCompanyMember adds the user as an invited member. Invited members have
+// no access until they accept; declining is removing themselves.
+func (s *CompanyService) InviteCompanyMember(companyID, userID, role string, requestingUserID string) (*db.CompanyMember, error) {
+	canManage, err := s.userCanManageCompanyMembers(requestingUserID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("user cannot add members to this company")
+	}
+
+	if err := ValidateRole(s.database, companyID, role); err != nil {
+		return nil, err
+	}
+	if err := s.checkCanAssignRole(companyID, role, requestingUserID); err != nil {
+		return nil, err
+	}
+
+	var existing db.CompanyMember
+	err = s.companyMemberRepo.FindOne(&existing, "company_id = ? AND user_id = ?", companyID, userID)
+	if err == nil {
+		return nil, errors.New("user is already a member of this company")
+	}
+
+	member := &db.CompanyMember{
+		CompanyID: companyID,
+		UserID:    userID,
+		Role:      role,
+		Status:    "invited",
+		InvitedAt: time.Now(),
+		InvitedBy: requestingUserID,
+	}
+	if err := s.companyMemberRepo.Create(member); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(events.CompanyMemberEvent(events.ActionMemberInvited, member, requestingUserID))
+	s.notifyCompanyMember(companyID, userID, notifications.InvitationReceived)
+
+	s.redactFor(member, requestingUserID)
+	return member, nil
+}
+
+// GetUserInvitations lists the user's pending invitations
+func (s *CompanyService) GetUserInvitations(userID string) ([]db.CompanyMember, error) {
+	var invitations []db.CompanyMember
+	if err := s.companyMemberRepo.FindWhere(&invitations, "user_id = ? AND status = ?", userID, "invited"); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// AcceptInvitation makes the user an active member of the company
+func (s *CompanyService) AcceptInvitation(companyID, userID string) (*db.CompanyMember, error) {
+	var member db.CompanyMember
+	if err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "invited"); err != nil {
+		return nil, errors.New("invitation not found")
+	}
+
+	now := time.Now()
+	member.Status = "active"
+	member.JoinedAt = &now
+	if err := s.companyMemberRepo.Update(&member); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(events.CompanyMemberEvent(events.ActionMemberUpdated, &member, userID))
+
+	s.redactFor(&member, userID)
+	return &member, nil
+}
@@ -0,0 +1,111 @@
+package companies
+
+import (
+	"errors"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+// Permissions a company role can carry. AdminPermission implies every other
+// permission, FinancePermission included.
+const (
+	AdminPermission          = "admin"
+	ManageMembersPermission  = "manage_members"
+	CreateProjectsPermission = "create_projects"
+	UpdatePermission         = "update"
+	ReadPermission           = "read"
+)
+
+// OwnerRole is held by the company owner's membership and cannot be assigned
+const OwnerRole = "owner"
+
+// DefaultRoles apply to companies whose template seeds no roles
+var DefaultRoles = []RoleTemplate{
+	{Name: "admin", Permissions: []string{AdminPermission, ManageMembersPermission, CreateProjectsPermission, UpdatePermission, ReadPermission}},
+	{Name: "manager", Permissions: []string{ManageMembersPermission, CreateProjectsPermission, UpdatePermission, ReadPermission}},
+	{Name: "member", Permissions: []string{ReadPermission}},
+}
+
+// Roles lists the roles the company's members can hold: the roles seeded
+// from its template, or DefaultRoles when it has none
+func Roles(database *pgconnect.DB, companyID string) ([]RoleTemplate, error) {
+	var companyRoles []db.CompanyRole
+	if err := database.Where("company_id = ?", companyID).Find(&companyRoles).Error; err != nil {
+		return nil, err
+	}
+	if len(companyRoles) == 0 {
+		return DefaultRoles, nil
+	}
+
+	roles := make([]RoleTemplate, len(companyRoles))
+	for i, role := range companyRoles {
+		roles[i] = RoleTemplate{Name: role.Name, Permissions: role.Permissions}
+	}
+	return roles, nil
+}
+
+// ValidateRole rejects roles the company does not define, and the owner role
+func ValidateRole(database *pgconnect.DB, companyID, role string) error {
+	if role == "" || role == OwnerRole {
+		return errors.New("invalid role")
+	}
+	roles, err := Roles(database, companyID)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if r.Name == role {
+			return nil
+		}
+	}
+	return errors.New("invalid role")
+}
+
+// RoleGrants reports whether a role of the company carries permission. Unknown
+// roles carry nothing.
+func RoleGrants(database *pgconnect.DB, companyID, role, permission string) (bool, error) {
+	roles, err := Roles(database, companyID)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roles {
+		if r.Name == role {
+			return grants(r.Permissions, permission), nil
+		}
+	}
+	return false, nil
+}
+
+// HasPermission reports whether the user owns the company or is an active
+// member whose role carries permission. A missing company returns
+// gorm.ErrRecordNotFound.
+func HasPermission(database *pgconnect.DB, companyID, userID, permission string) (bool, error) {
+	var company db.Company
+	if err := database.Where("id = ?", companyID).First(&company).Error; err != nil {
+		return false, err
+	}
+	if company.OwnerID == userID {
+		return true, nil
+	}
+
+	var member db.CompanyMember
+	err := database.Where("company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active").First(&member).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return RoleGrants(database, companyID, member.Role, permission)
+}
+
+func grants(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission || p == AdminPermission {
+			return true
+		}
+	}
+	return false
+}
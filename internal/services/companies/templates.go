@@ -0,0 +1,140 @@
+package companies
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// CompanyTemplate describes what a new company of a given type starts with
+type CompanyTemplate struct {
+	Type            string                   `json:"type"`
+	Description     string                   `json:"description"`
+	Roles           []RoleTemplate           `json:"roles"`
+	StarterProjects []StarterProjectTemplate `json:"starterProjects"`
+}
+
+type RoleTemplate struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type StarterProjectTemplate struct {
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	DurationDays *int    `json:"durationDays"` // EndDate offset from the company creation date
+}
+
+// TemplateRegistry holds company templates keyed on Company.Type
+type TemplateRegistry struct {
+	mu        sync.RWMutex
+	templates map[string]CompanyTemplate
+}
+
+// NewTemplateRegistry returns a registry with the built-in enterprise, school and personal templates
+func NewTemplateRegistry() *TemplateRegistry {
+	registry := &TemplateRegistry{templates: make(map[string]CompanyTemplate)}
+	for _, template := range builtinTemplates() {
+		registry.Register(template)
+	}
+	return registry
+}
+
+// Register adds a template, replacing any existing template for the same type
+func (r *TemplateRegistry) Register(template CompanyTemplate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.templates[template.Type] = template
+}
+
+func (r *TemplateRegistry) Get(companyType string) (CompanyTemplate, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	template, ok := r.templates[companyType]
+	return template, ok
+}
+
+func (r *TemplateRegistry) List() []CompanyTemplate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	templates := make([]CompanyTemplate, 0, len(r.templates))
+	for _, template := range r.templates {
+		templates = append(templates, template)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Type < templates[j].Type })
+	return templates
+}
+
+// LoadDir registers every *.json file in dir as a template. Files may hold a
+// single template or an array of templates; custom types override built-ins.
+func (r *TemplateRegistry) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		var templates []CompanyTemplate
+		if err := json.Unmarshal(data, &templates); err != nil {
+			var template CompanyTemplate
+			if err := json.Unmarshal(data, &template); err != nil {
+				return fmt.Errorf("invalid company template %s: %w", file, err)
+			}
+			templates = []CompanyTemplate{template}
+		}
+
+		for _, template := range templates {
+			if template.Type == "" {
+				return fmt.Errorf("invalid company template %s: type is required", file)
+			}
+			r.Register(template)
+		}
+	}
+
+	return nil
+}
+
+func builtinTemplates() []CompanyTemplate {
+	onboardingDays := 30
+	return []CompanyTemplate{
+		{
+			Type:        "enterprise",
+			Description: "Multi-user organization with admin, manager, finance and employee roles",
+			Roles: []RoleTemplate{
+				{Name: "admin", Permissions: []string{"admin", "manage_members", "create_projects", "update", "read"}},
+				{Name: "manager", Permissions: []string{"manage_members", "create_projects", "update", "read"}},
+				{Name: "finance", Permissions: []string{"finance", "read"}},
+				{Name: "employee", Permissions: []string{"read"}},
+			},
+			StarterProjects: []StarterProjectTemplate{
+				{Title: "Onboarding", DurationDays: &onboardingDays},
+			},
+		},
+		{
+			Type:        "school",
+			Description: "Educational institution with teacher and student roles",
+			Roles: []RoleTemplate{
+				{Name: "admin", Permissions: []string{"admin", "manage_members", "create_projects", "update", "read"}},
+				{Name: "teacher", Permissions: []string{"manage_members", "create_projects", "update", "read"}},
+				{Name: "student", Permissions: []string{"read"}},
+			},
+			StarterProjects: []StarterProjectTemplate{
+				{Title: "Courses"},
+				{Title: "Enrollment"},
+			},
+		},
+		{
+			Type:        "personal",
+			Description: "Private space for an individual's projects",
+		},
+	}
+}
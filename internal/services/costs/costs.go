@@ -0,0 +1,377 @@
+package costs
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+const (
+	// DefaultCurrency applies to members whose compensation has no currency
+	DefaultCurrency = companies.DefaultCurrency
+	// DefaultHoursPerMonth converts hourly rates to a monthly cost
+	DefaultHoursPerMonth = 160
+	// MaxReportMonths bounds the size of a single report
+	MaxReportMonths = 36
+)
+
+type CostService struct {
+	database          *pgconnect.DB
+	projectRepo       *pgconnect.Repository[db.BaseProject]
+	projectMemberRepo *pgconnect.Repository[db.ProjectMember]
+	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+	rateRepo          *pgconnect.Repository[db.CompensationRate]
+}
+
+func NewCostService(database *pgconnect.DB) *CostService {
+	return &CostService{
+		database:          database,
+		projectRepo:       pgconnect.NewRepository[db.BaseProject](database),
+		projectMemberRepo: pgconnect.NewRepository[db.ProjectMember](database),
+		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+		rateRepo:          pgconnect.NewRepository[db.CompensationRate](database),
+	}
+}
+
+// CostOptions selects the months covered by a report. Salary is treated as
+// an annual amount and HourlyRate is multiplied by HoursPerMonth. Each month
+// uses the latest compensation rate that took effect before the month ended.
+type CostOptions struct {
+	From          time.Time // First month, inclusive
+	To            time.Time // Last month, inclusive
+	HoursPerMonth float64
+}
+
+// CostLine is one member's estimated cost for one month. A member's monthly
+// compensation is split evenly across the projects they worked on that month;
+// ProjectID is nil for compensation not allocated to any project.
+type CostLine struct {
+	Month               time.Time
+	UserID              string
+	Role                string
+	ProjectID           *uint
+	Currency            string
+	MonthlyCompensation float64
+	Share               float64
+	Amount              float64
+}
+
+type MonthlyTotal struct {
+	Month    time.Time
+	Currency string
+	Amount   float64
+}
+
+type CostReport struct {
+	CompanyID     string
+	ProjectID     *uint
+	From          time.Time
+	To            time.Time
+	HoursPerMonth float64
+	Lines         []CostLine
+	Monthly       []MonthlyTotal
+	Totals        map[string]float64 // Amount per currency
+}
+
+func (s *CostService) GetCompanyCostReport(companyID, userID string, options CostOptions) (*CostReport, error) {
+	if !s.userCanViewCosts(userID, companyID) {
+		return nil, errors.New("user cannot view costs for this company")
+	}
+
+	if err := normalizeOptions(&options); err != nil {
+		return nil, err
+	}
+
+	lines, err := s.buildLines(companyID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReport(companyID, nil, options, lines), nil
+}
+
+func (s *CostService) GetProjectCostReport(projectID uint, userID string, options CostOptions) (*CostReport, error) {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
+		return nil, err
+	}
+
+	// Compensation is company data, so only company projects have a cost
+	if project.CompanyID == nil {
+		return nil, errors.New("project does not belong to a company")
+	}
+	if !s.userCanViewCosts(userID, *project.CompanyID) {
+		return nil, errors.New("user cannot view costs for this company")
+	}
+
+	// Default to the project's own date range
+	if options.From.IsZero() && project.StartDate != nil {
+		options.From = *project.StartDate
+	}
+	if options.To.IsZero() && project.EndDate != nil {
+		options.To = *project.EndDate
+	}
+	if err := normalizeOptions(&options); err != nil {
+		return nil, err
+	}
+
+	lines, err := s.buildLines(*project.CompanyID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectLines []CostLine
+	for _, line := range lines {
+		if line.ProjectID != nil && *line.ProjectID == projectID {
+			projectLines = append(projectLines, line)
+		}
+	}
+
+	return newReport(*project.CompanyID, &projectID, options, projectLines), nil
+}
+
+// Private helper methods
+
+func (s *CostService) buildLines(companyID string, options CostOptions) ([]CostLine, error) {
+	var members []db.CompanyMember
+	if err := s.companyMemberRepo.FindWhere(&members, "company_id = ? AND status = ?", companyID, "active"); err != nil {
+		return nil, err
+	}
+
+	var rates []db.CompensationRate
+	if err := s.rateRepo.FindWhere(&rates, "company_id = ?", companyID); err != nil {
+		return nil, err
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].EffectiveFrom.Equal(rates[j].EffectiveFrom) {
+			return rates[i].ID < rates[j].ID
+		}
+		return rates[i].EffectiveFrom.Before(rates[j].EffectiveFrom)
+	})
+	ratesByUser := make(map[string][]db.CompensationRate)
+	for _, rate := range rates {
+		ratesByUser[rate.UserID] = append(ratesByUser[rate.UserID], rate)
+	}
+
+	var projects []db.BaseProject
+	if err := s.projectRepo.FindWhere(&projects, "company_id = ? AND status <> ?", companyID, "cancelled"); err != nil {
+		return nil, err
+	}
+
+	// Which projects each user works on, and since when
+	joined := make(map[string]map[uint]time.Time)
+	addProject := func(userID string, projectID uint, since time.Time) {
+		if joined[userID] == nil {
+			joined[userID] = make(map[uint]time.Time)
+		}
+		if current, ok := joined[userID][projectID]; !ok || since.Before(current) {
+			joined[userID][projectID] = since
+		}
+	}
+
+	projectIDs := make([]string, len(projects))
+	for i, project := range projects {
+		projectIDs[i] = strconv.Itoa(int(project.ID))
+		addProject(project.OwnerID, project.ID, project.CreatedAt)
+	}
+
+	if len(projectIDs) > 0 {
+		var projectMembers []db.ProjectMember
+		if err := s.projectMemberRepo.FindWhere(&projectMembers, "project_type = ? AND project_id IN ?", "core", projectIDs); err != nil {
+			return nil, err
+		}
+		for _, member := range projectMembers {
+			id, err := strconv.ParseUint(member.ProjectID, 10, 32)
+			if err != nil {
+				continue
+			}
+			addProject(member.UserID, uint(id), member.JoinedAt)
+		}
+	}
+
+	var lines []CostLine
+	for month := options.From; !month.After(options.To); month = month.AddDate(0, 1, 0) {
+		monthEnd := month.AddDate(0, 1, 0)
+
+		for _, member := range members {
+			if member.JoinedAt != nil && !member.JoinedAt.Before(monthEnd) {
+				continue
+			}
+
+			salary, hourlyRate, currency := member.Salary, member.HourlyRate, member.Currency
+			if history, ok := ratesByUser[member.UserID]; ok {
+				rate := rateAt(history, monthEnd)
+				if rate == nil {
+					continue
+				}
+				salary, hourlyRate, currency = rate.Salary, rate.HourlyRate, rate.Currency
+			}
+			if salary == nil && hourlyRate == nil {
+				continue
+			}
+
+			compensation := monthlyCompensation(salary, hourlyRate, options.HoursPerMonth)
+			if currency == "" {
+				currency = DefaultCurrency
+			}
+
+			var active []uint
+			for _, project := range projects {
+				since, ok := joined[member.UserID][project.ID]
+				if ok && since.Before(monthEnd) && projectActiveIn(&project, month, monthEnd) {
+					active = append(active, project.ID)
+				}
+			}
+
+			if len(active) == 0 {
+				lines = append(lines, CostLine{
+					Month:               month,
+					UserID:              member.UserID,
+					Role:                member.Role,
+					Currency:            currency,
+					MonthlyCompensation: compensation,
+					Share:               1,
+					Amount:              round(compensation),
+				})
+				continue
+			}
+
+			share := 1 / float64(len(active))
+			for _, projectID := range active {
+				lines = append(lines, CostLine{
+					Month:               month,
+					UserID:              member.UserID,
+					Role:                member.Role,
+					ProjectID:           &projectID,
+					Currency:            currency,
+					MonthlyCompensation: compensation,
+					Share:               share,
+					Amount:              round(compensation * share),
+				})
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+func newReport(companyID string, projectID *uint, options CostOptions, lines []CostLine) *CostReport {
+	report := &CostReport{
+		CompanyID:     companyID,
+		ProjectID:     projectID,
+		From:          options.From,
+		To:            options.To,
+		HoursPerMonth: options.HoursPerMonth,
+		Lines:         lines,
+		Totals:        make(map[string]float64),
+	}
+
+	type monthKey struct {
+		month    time.Time
+		currency string
+	}
+	monthly := make(map[monthKey]float64)
+	for _, line := range lines {
+		monthly[monthKey{line.Month, line.Currency}] += line.Amount
+		report.Totals[line.Currency] += line.Amount
+	}
+
+	for key, amount := range monthly {
+		report.Monthly = append(report.Monthly, MonthlyTotal{Month: key.month, Currency: key.currency, Amount: round(amount)})
+	}
+	sort.Slice(report.Monthly, func(i, j int) bool {
+		if report.Monthly[i].Month.Equal(report.Monthly[j].Month) {
+			return report.Monthly[i].Currency < report.Monthly[j].Currency
+		}
+		return report.Monthly[i].Month.Before(report.Monthly[j].Month)
+	})
+	for currency, amount := range report.Totals {
+		report.Totals[currency] = round(amount)
+	}
+
+	return report
+}
+
+func normalizeOptions(options *CostOptions) error {
+	now := time.Now()
+	if options.From.IsZero() {
+		options.From = now
+	}
+	if options.To.IsZero() {
+		options.To = options.From
+	}
+	options.From = startOfMonth(options.From)
+	options.To = startOfMonth(options.To)
+
+	if options.To.Before(options.From) {
+		return errors.New("report end is before its start")
+	}
+	if options.From.AddDate(0, MaxReportMonths, 0).Before(options.To) {
+		return errors.New("report range exceeds " + strconv.Itoa(MaxReportMonths) + " months")
+	}
+	if options.HoursPerMonth <= 0 {
+		options.HoursPerMonth = DefaultHoursPerMonth
+	}
+	return nil
+}
+
+func monthlyCompensation(salary, hourlyRate *float64, hoursPerMonth float64) float64 {
+	var amount float64
+	if salary != nil {
+		amount += *salary / 12
+	}
+	if hourlyRate != nil {
+		amount += *hourlyRate * hoursPerMonth
+	}
+	return amount
+}
+
+// rateAt returns the latest rate that took effect before end, from a history
+// sorted by EffectiveFrom
+func rateAt(history []db.CompensationRate, end time.Time) *db.CompensationRate {
+	var rate *db.CompensationRate
+	for i := range history {
+		if !history[i].EffectiveFrom.Before(end) {
+			break
+		}
+		rate = &history[i]
+	}
+	return rate
+}
+
+// projectActiveIn reports whether a project's date range overlaps [monthStart, monthEnd)
+func projectActiveIn(project *db.BaseProject, monthStart, monthEnd time.Time) bool {
+	start := project.CreatedAt
+	if project.StartDate != nil {
+		start = *project.StartDate
+	}
+	if !start.Before(monthEnd) {
+		return false
+	}
+
+	end := project.EndDate
+	if project.CompletedAt != nil && (end == nil || project.CompletedAt.Before(*end)) {
+		end = project.CompletedAt
+	}
+	return end == nil || !end.Before(monthStart)
+}
+
+func startOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func (s *CostService) userCanViewCosts(userID, companyID string) bool {
+	// Business rule: compensation data is limited to the owner and finance roles
+	allowed, err := companies.HasPermission(s.database, companyID, userID, companies.FinancePermission)
+	return err == nil && allowed
+}
@@ -0,0 +1,452 @@
+package customfields
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+const (
+	TypeText        = "text"
+	TypeNumber      = "number"
+	TypeBoolean     = "boolean"
+	TypeDate        = "date"
+	TypeSelect      = "select"
+	TypeMultiselect = "multiselect"
+)
+
+const dateLayout = "2006-01-02"
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+type CustomFieldService struct {
+	database          *pgconnect.DB
+	definitionRepo    *pgconnect.Repository[db.CustomFieldDefinition]
+	companyRepo       *pgconnect.Repository[db.Company]
+	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+	projectTypeRepo   *pgconnect.Repository[db.ProjectType]
+}
+
+func NewCustomFieldService(database *pgconnect.DB) *CustomFieldService {
+	return &CustomFieldService{
+		database:          database,
+		definitionRepo:    pgconnect.NewRepository[db.CustomFieldDefinition](database),
+		companyRepo:       pgconnect.NewRepository[db.Company](database),
+		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+		projectTypeRepo:   pgconnect.NewRepository[db.ProjectType](database),
+	}
+}
+
+// Definitions of a company are managed by its owner and admins, identified by
+// userID. Definitions of a project type are managed by the service that
+// registered the type.
+
+func (s *CustomFieldService) CreateDefinition(definition *db.CustomFieldDefinition, userID, service string) (*db.CustomFieldDefinition, error) {
+	if err := validateDefinition(definition); err != nil {
+		return nil, err
+	}
+	if err := s.checkCanManage(definition, userID, service); err != nil {
+		return nil, err
+	}
+
+	// Keys are unique within a company or project type
+	var existing db.CustomFieldDefinition
+	err := s.scopeQuery(definition).Where("key = ?", definition.Key).First(&existing).Error
+	if err == nil {
+		return nil, errors.New("custom field key already exists")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if definition.Label == "" {
+		definition.Label = definition.Key
+	}
+	definition.CreatedBy = userID
+	if definition.ProjectType != nil {
+		definition.CreatedBy = service
+	}
+	now := time.Now()
+	definition.CreatedAt = now
+	definition.UpdatedAt = now
+
+	if err := s.definitionRepo.Create(definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// GetDefinitions lists the definitions of a company, for its members, or of a
+// project type, ordered by key
+func (s *CustomFieldService) GetDefinitions(companyID, projectType *string, userID string) ([]db.CustomFieldDefinition, error) {
+	if (companyID == nil) == (projectType == nil) {
+		return nil, errors.New("company ID or project type is required")
+	}
+
+	if companyID != nil {
+		canAccess, err := s.userCanAccessCompany(userID, *companyID)
+		if err != nil {
+			return nil, err
+		}
+		if !canAccess {
+			return nil, errors.New("user cannot access this company")
+		}
+	} else if _, err := s.getProjectType(*projectType); err != nil {
+		return nil, err
+	}
+
+	scope := &db.CustomFieldDefinition{CompanyID: companyID, ProjectType: projectType}
+	var definitions []db.CustomFieldDefinition
+	if err := s.scopeQuery(scope).Order("key").Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
+// UpdateDefinition changes the label, required flag and allowed values. Key
+// and type are fixed, since stored values depend on them. An empty label, nil
+// required or nil allowed values keep the current value.
+func (s *CustomFieldService) UpdateDefinition(id uint, label string, required *bool, allowedValues []string, userID, service string) (*db.CustomFieldDefinition, error) {
+	definition, err := s.getDefinition(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanManage(definition, userID, service); err != nil {
+		return nil, err
+	}
+
+	if label != "" {
+		definition.Label = label
+	}
+	if required != nil {
+		definition.Required = *required
+	}
+	if allowedValues != nil {
+		definition.AllowedValues = allowedValues
+	}
+	if err := validateDefinition(definition); err != nil {
+		return nil, err
+	}
+	definition.UpdatedAt = time.Now()
+
+	if err := s.definitionRepo.Update(definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// DeleteDefinition removes a definition and its values from the projects in
+// its scope, except projects where the other scope defines the same key
+func (s *CustomFieldService) DeleteDefinition(id uint, userID, service string) error {
+	definition, err := s.getDefinition(id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkCanManage(definition, userID, service); err != nil {
+		return err
+	}
+
+	return s.database.WithTransaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(definition).Error; err != nil {
+			return err
+		}
+
+		projects := tx.Model(&db.BaseProject{})
+		if definition.CompanyID != nil {
+			typesWithKey := tx.Model(&db.CustomFieldDefinition{}).Select("project_type").Where("key = ? AND project_type IS NOT NULL", definition.Key)
+			projects = projects.Where("company_id = ? AND (project_type IS NULL OR project_type NOT IN (?))", *definition.CompanyID, typesWithKey)
+		} else {
+			companiesWithKey := tx.Model(&db.CustomFieldDefinition{}).Select("company_id").Where("key = ? AND company_id IS NOT NULL", definition.Key)
+			projects = projects.Where("project_type = ? AND (company_id IS NULL OR company_id NOT IN (?))", *definition.ProjectType, companiesWithKey)
+		}
+		return projects.UpdateColumn("custom_fields", gorm.Expr("custom_fields - ?", definition.Key)).Error
+	})
+}
+
+// ApplyValues validates input against the definitions that apply to a project
+// and merges it into the current values. A null input value clears the field.
+// Current values whose definition no longer applies, e.g. after the project
+// moved to another company, are dropped. When a company and a project type
+// define the same key, the later definition in the list wins.
+func ApplyValues(definitions []db.CustomFieldDefinition, current, input map[string]interface{}) (map[string]interface{}, error) {
+	byKey := definitionsByKey(definitions)
+	values := pruneValues(byKey, current)
+
+	for _, key := range sortedKeys(input) {
+		definition, ok := byKey[key]
+		if !ok {
+			return nil, fieldError(key, "not defined for this project")
+		}
+		if input[key] == nil {
+			delete(values, key)
+			continue
+		}
+		value, err := normalizeValue(&definition, input[key])
+		if err != nil {
+			return nil, fieldError(key, err.Error())
+		}
+		values[key] = value
+	}
+
+	for _, key := range sortedKeys(byKey) {
+		if _, ok := values[key]; !ok && byKey[key].Required {
+			return nil, fieldError(key, "value is required")
+		}
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
+// MatchesValue reports whether a stored value equals want as written in a
+// query string. Multiselect values match any of their options.
+func MatchesValue(value interface{}, want string) bool {
+	switch v := value.(type) {
+	case string:
+		return v == want
+	case float64:
+		number, err := strconv.ParseFloat(want, 64)
+		return err == nil && number == v
+	case bool:
+		return strconv.FormatBool(v) == want
+	case []interface{}:
+		for _, option := range v {
+			if option == want {
+				return true
+			}
+		}
+	case []string:
+		for _, option := range v {
+			if option == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Private helper methods
+
+func (s *CustomFieldService) getDefinition(id uint) (*db.CustomFieldDefinition, error) {
+	var definition db.CustomFieldDefinition
+	if err := s.definitionRepo.FindByID(id, &definition); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("custom field not found")
+		}
+		return nil, err
+	}
+	return &definition, nil
+}
+
+func (s *CustomFieldService) checkCanManage(definition *db.CustomFieldDefinition, userID, service string) error {
+	if definition.CompanyID != nil {
+		allowed, err := companies.HasPermission(s.database, *definition.CompanyID, userID, companies.AdminPermission)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("company not found")
+		}
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errors.New("user cannot manage custom fields in this company")
+		}
+		return nil
+	}
+
+	projectType, err := s.getProjectType(*definition.ProjectType)
+	if err != nil {
+		return err
+	}
+	if projectType.Service != service {
+		return errors.New("project type is registered by another service")
+	}
+	return nil
+}
+
+func (s *CustomFieldService) userCanAccessCompany(userID, companyID string) (bool, error) {
+	var company db.Company
+	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, errors.New("company not found")
+		}
+		return false, err
+	}
+	if company.OwnerID == userID {
+		return true, nil
+	}
+
+	var member db.CompanyMember
+	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
+	return err == nil, nil
+}
+
+func (s *CustomFieldService) getProjectType(name string) (*db.ProjectType, error) {
+	var projectType db.ProjectType
+	if err := s.projectTypeRepo.FindByID(name, &projectType); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("project type not found")
+		}
+		return nil, err
+	}
+	return &projectType, nil
+}
+
+// scopeQuery selects the definitions of the company or project type of definition
+func (s *CustomFieldService) scopeQuery(definition *db.CustomFieldDefinition) *gorm.DB {
+	if definition.CompanyID != nil {
+		return s.database.Where("company_id = ?", *definition.CompanyID)
+	}
+	return s.database.Where("project_type = ?", *definition.ProjectType)
+}
+
+func validateDefinition(definition *db.CustomFieldDefinition) error {
+	if (definition.CompanyID == nil) == (definition.ProjectType == nil) {
+		return errors.New("custom field needs either a company ID or a project type")
+	}
+	if !keyPattern.MatchString(definition.Key) {
+		return errors.New("invalid custom field key")
+	}
+
+	switch definition.Type {
+	case TypeText, TypeNumber, TypeBoolean, TypeDate:
+		if len(definition.AllowedValues) > 0 {
+			return errors.New("allowed values only apply to select and multiselect fields")
+		}
+	case TypeSelect, TypeMultiselect:
+		if len(definition.AllowedValues) == 0 {
+			return errors.New("select and multiselect fields need allowed values")
+		}
+		seen := make(map[string]bool, len(definition.AllowedValues))
+		for _, value := range definition.AllowedValues {
+			if value == "" || seen[value] {
+				return errors.New("allowed values must be unique and not empty")
+			}
+			seen[value] = true
+		}
+	default:
+		return errors.New("invalid custom field type")
+	}
+	return nil
+}
+
+// normalizeValue checks value against the field type and returns it in its
+// stored form; dates are stored as YYYY-MM-DD
+func normalizeValue(definition *db.CustomFieldDefinition, value interface{}) (interface{}, error) {
+	switch definition.Type {
+	case TypeText:
+		if text, ok := value.(string); ok {
+			return text, nil
+		}
+		return nil, errors.New("expected text")
+	case TypeNumber:
+		switch number := value.(type) {
+		case float64:
+			return number, nil
+		case int:
+			return float64(number), nil
+		}
+		return nil, errors.New("expected a number")
+	case TypeBoolean:
+		if flag, ok := value.(bool); ok {
+			return flag, nil
+		}
+		return nil, errors.New("expected true or false")
+	case TypeDate:
+		if text, ok := value.(string); ok {
+			if date, err := time.Parse(dateLayout, text); err == nil {
+				return date.Format(dateLayout), nil
+			}
+			if date, err := time.Parse(time.RFC3339, text); err == nil {
+				return date.Format(dateLayout), nil
+			}
+		}
+		return nil, errors.New("expected a date (YYYY-MM-DD)")
+	case TypeSelect:
+		if option, ok := value.(string); ok && allowed(definition, option) {
+			return option, nil
+		}
+		return nil, errors.New("expected one of " + strings.Join(definition.AllowedValues, ", "))
+	case TypeMultiselect:
+		var options []string
+		switch list := value.(type) {
+		case []string:
+			options = list
+		case []interface{}:
+			for _, item := range list {
+				option, ok := item.(string)
+				if !ok {
+					return nil, errors.New("expected a list of " + strings.Join(definition.AllowedValues, ", "))
+				}
+				options = append(options, option)
+			}
+		default:
+			return nil, errors.New("expected a list of " + strings.Join(definition.AllowedValues, ", "))
+		}
+		selected := make([]string, 0, len(options))
+		for _, option := range options {
+			if !allowed(definition, option) {
+				return nil, errors.New("expected a list of " + strings.Join(definition.AllowedValues, ", "))
+			}
+			if !contains(selected, option) {
+				selected = append(selected, option)
+			}
+		}
+		return selected, nil
+	}
+	return nil, errors.New("unknown field type")
+}
+
+func definitionsByKey(definitions []db.CustomFieldDefinition) map[string]db.CustomFieldDefinition {
+	byKey := make(map[string]db.CustomFieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+	return byKey
+}
+
+func pruneValues(byKey map[string]db.CustomFieldDefinition, current map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	for key, value := range current {
+		if _, ok := byKey[key]; ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+func fieldError(key, message string) error {
+	return fmt.Errorf("invalid custom field %q: %s", key, message)
+}
+
+func allowed(definition *db.CustomFieldDefinition, value string) bool {
+	return contains(definition.AllowedValues, value)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,277 @@
+package email
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "EmailService"),
+)
+
+// Queue settings
+const (
+	DefaultMaxAttempts = 5
+	queueBatchSize     = 50
+	firstRetryDelay    = time.Minute
+	maxRetryDelay      = time.Hour
+)
+
+// Message statuses in the send queue
+const (
+	StatusPending   = "pending"
+	StatusSent      = "sent"
+	StatusFailed    = "failed"    // Gave up after MaxAttempts
+	StatusCancelled = "cancelled" // The user unsubscribed before it was sent
+)
+
+type Config struct {
+	AppURL        string // Linked from every email; optional
+	PublicURL     string // Base URL of this API, used for unsubscribe links
+	Secret        []byte // Signs unsubscribe links
+	DefaultLocale string
+	MaxAttempts   int
+}
+
+type EmailService struct {
+	transport    Transport
+	renderer     *renderer
+	config       Config
+	database     *pgconnect.DB
+	settingsRepo *pgconnect.Repository[db.EmailSettings]
+	messageRepo  *pgconnect.Repository[db.EmailMessage]
+}
+
+func NewEmailService(database *pgconnect.DB, transport Transport, config Config) (*EmailService, error) {
+	if len(config.Secret) == 0 {
+		return nil, errors.New("email secret is required")
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultMaxAttempts
+	}
+	config.PublicURL = strings.TrimSuffix(config.PublicURL, "/")
+
+	renderer, err := newRenderer()
+	if err != nil {
+		return nil, err
+	}
+	if config.DefaultLocale == "" {
+		config.DefaultLocale = "en"
+	}
+	if !renderer.supports(config.DefaultLocale) {
+		return nil, errors.New("unsupported default locale: " + config.DefaultLocale)
+	}
+
+	return &EmailService{
+		transport:    transport,
+		renderer:     renderer,
+		config:       config,
+		database:     database,
+		settingsRepo: pgconnect.NewRepository[db.EmailSettings](database),
+		messageRepo:  pgconnect.NewRepository[db.EmailMessage](database),
+	}, nil
+}
+
+func (s *EmailService) GetSettings(userID string) (*db.EmailSettings, error) {
+	var settings db.EmailSettings
+	if err := s.settingsRepo.FindOne(&settings, "user_id = ?", userID); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// UpdateSettings sets the user's address and locale. Saving settings is an
+// explicit opt-in, so it also undoes an earlier unsubscribe.
+func (s *EmailService) UpdateSettings(userID, address, locale string) (*db.EmailSettings, error) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Address != address {
+		return nil, errors.New("invalid email address")
+	}
+	if locale == "" {
+		locale = s.config.DefaultLocale
+	}
+	if !s.renderer.supports(locale) {
+		return nil, errors.New("unsupported locale")
+	}
+
+	settings := &db.EmailSettings{
+		UserID:    userID,
+		Email:     address,
+		Locale:    locale,
+		UpdatedAt: time.Now(),
+	}
+	err = s.database.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "locale", "unsubscribed_at", "updated_at"}),
+	}).Create(settings).Error
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// Deliver queues an email for a stored notification. It implements
+// notifications.Channel; types without email templates and users without
+// settings or who unsubscribed are skipped.
+func (s *EmailService) Deliver(notification *db.Notification) error {
+	if !slices.Contains(Types, notification.Type) {
+		return nil
+	}
+
+	settings, err := s.GetSettings(notification.UserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if settings.UnsubscribedAt != nil {
+		return nil
+	}
+
+	locale := settings.Locale
+	if !s.renderer.supports(locale) {
+		locale = s.config.DefaultLocale
+	}
+	unsubscribeURL := s.UnsubscribeURL(notification.UserID)
+	message, err := s.renderer.render(locale, notification.Type, templateData{
+		Data:           notification.Data,
+		AppURL:         s.config.AppURL,
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return s.messageRepo.Create(&db.EmailMessage{
+		UserID:         notification.UserID,
+		NotificationID: &notification.ID,
+		To:             settings.Email,
+		Subject:        message.Subject,
+		TextBody:       message.Text,
+		HTMLBody:       message.HTML,
+		UnsubscribeURL: unsubscribeURL,
+		Status:         StatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	})
+}
+
+// ProcessQueue sends every due message. Failures are retried with
+// exponential backoff until MaxAttempts, then marked failed.
+func (s *EmailService) ProcessQueue(ctx context.Context) error {
+	for {
+		var batch []db.EmailMessage
+		err := s.database.Where("status = ? AND next_attempt_at <= ?", StatusPending, time.Now()).
+			Order("id").Limit(queueBatchSize).Find(&batch).Error
+		if err != nil {
+			return err
+		}
+
+		for i := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := s.sendQueued(ctx, &batch[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < queueBatchSize {
+			return nil
+		}
+	}
+}
+
+// UnsubscribeURL returns the signed link that stops all email to the user
+func (s *EmailService) UnsubscribeURL(userID string) string {
+	return s.config.PublicURL + "/email/unsubscribe/" + s.unsubscribeToken(userID)
+}
+
+// Unsubscribe stops all email to the user the token was issued for
+func (s *EmailService) Unsubscribe(token string) error {
+	encodedUserID, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return errors.New("invalid unsubscribe token")
+	}
+	userID, err := base64.RawURLEncoding.DecodeString(encodedUserID)
+	if err != nil || !hmac.Equal([]byte(token), []byte(s.unsubscribeToken(string(userID)))) {
+		return errors.New("invalid unsubscribe token")
+	}
+
+	now := time.Now()
+	return s.database.Model(&db.EmailSettings{}).
+		Where("user_id = ? AND unsubscribed_at IS NULL", string(userID)).
+		Updates(map[string]interface{}{"unsubscribed_at": now, "updated_at": now}).Error
+}
+
+// Private helper methods
+
+// sendQueued sends one message and records the outcome. Only database errors
+// are returned; send errors are stored on the message.
+func (s *EmailService) sendQueued(ctx context.Context, message *db.EmailMessage) error {
+	// Unsubscribing also stops messages already in the queue
+	settings, err := s.GetSettings(message.UserID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if settings == nil || settings.UnsubscribedAt != nil {
+		message.Status = StatusCancelled
+		return s.messageRepo.Update(message)
+	}
+
+	message.Attempts++
+	sendErr := s.transport.Send(ctx, &Message{
+		To:             message.To,
+		Subject:        message.Subject,
+		Text:           message.TextBody,
+		HTML:           message.HTMLBody,
+		UnsubscribeURL: message.UnsubscribeURL,
+	})
+
+	now := time.Now()
+	switch {
+	case sendErr == nil:
+		message.Status = StatusSent
+		message.SentAt = &now
+		message.LastError = ""
+	case message.Attempts >= s.config.MaxAttempts:
+		message.Status = StatusFailed
+		message.LastError = sendErr.Error()
+		log.Error("email:failed", "messageID", message.ID, "attempts", message.Attempts, "error", sendErr)
+	default:
+		message.NextAttemptAt = now.Add(retryDelay(message.Attempts))
+		message.LastError = sendErr.Error()
+	}
+	return s.messageRepo.Update(message)
+}
+
+func (s *EmailService) unsubscribeToken(userID string) string {
+	mac := hmac.New(sha256.New, s.config.Secret)
+	mac.Write([]byte("unsubscribe:" + userID))
+	return base64.RawURLEncoding.EncodeToString([]byte(userID)) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay doubles after every failed attempt, up to maxRetryDelay
+func retryDelay(attempts int) time.Duration {
+	delay := firstRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
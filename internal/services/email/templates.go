@@ -0,0 +1,105 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+)
+
+// Types lists the notification types sent by email
+var Types = []string{
+	notifications.TypeInvitationReceived,
+	notifications.TypeMemberAdded,
+	notifications.TypeDeadlineApproaching,
+	notifications.TypeProjectOverdue,
+}
+
+// templateFS holds one directory per locale. Each has layout.txt.tmpl and
+// layout.html.tmpl, and per type <type>.txt.tmpl defining "subject" and
+// "content" and <type>.html.tmpl defining "content".
+//
+//go:embed templates
+var templateFS embed.FS
+
+// templateData is what templates render
+type templateData struct {
+	Title          string            // The rendered subject
+	Data           map[string]string // Notification data, e.g. project, company, role, due
+	AppURL         string
+	UnsubscribeURL string
+}
+
+type renderer struct {
+	text map[string]*texttemplate.Template // Keyed by locale/type
+	html map[string]*htmltemplate.Template
+}
+
+func newRenderer() (*renderer, error) {
+	r := &renderer{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+
+	locales, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, err
+	}
+	for _, locale := range locales {
+		dir := "templates/" + locale.Name()
+		for _, notificationType := range Types {
+			key := locale.Name() + "/" + notificationType
+			textTemplate, err := texttemplate.New("layout.txt.tmpl").Option("missingkey=zero").
+				ParseFS(templateFS, dir+"/layout.txt.tmpl", dir+"/"+notificationType+".txt.tmpl")
+			if err != nil {
+				return nil, err
+			}
+			htmlTemplate, err := htmltemplate.New("layout.html.tmpl").Option("missingkey=zero").
+				ParseFS(templateFS, dir+"/layout.html.tmpl", dir+"/"+notificationType+".html.tmpl")
+			if err != nil {
+				return nil, err
+			}
+			r.text[key] = textTemplate
+			r.html[key] = htmlTemplate
+		}
+	}
+	return r, nil
+}
+
+func (r *renderer) supports(locale string) bool {
+	_, ok := r.text[locale+"/"+Types[0]]
+	return ok
+}
+
+func (r *renderer) render(locale, notificationType string, data templateData) (*Message, error) {
+	key := locale + "/" + notificationType
+	textTemplate, ok := r.text[key]
+	if !ok {
+		return nil, errors.New("no email template for " + key)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := textTemplate.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	// Line breaks in a subject would end the header
+	data.Title = strings.Join(strings.Fields(subject.String()), " ")
+
+	if err := textTemplate.Execute(&text, data); err != nil {
+		return nil, err
+	}
+	if err := r.html[key].Execute(&html, data); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		Subject: data.Title,
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
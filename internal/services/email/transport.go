@@ -0,0 +1,255 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Message is one email ready to be sent
+type Message struct {
+	To             string
+	Subject        string
+	Text           string
+	HTML           string
+	UnsubscribeURL string
+}
+
+// Transport sends email. Implementations must be safe for concurrent use.
+type Transport interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+// smtpTimeout bounds a whole SMTP exchange when the context has no earlier deadline
+const smtpTimeout = 30 * time.Second
+
+// SMTPTransport sends through an SMTP server, with STARTTLS when offered
+type SMTPTransport struct {
+	Addr     string // host:port
+	Username string // Empty to send without authentication
+	Password string
+	From     string
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, message *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	raw, err := buildMIME(t.From, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", t.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The deadline covers every read and write; cancelling the context
+	// unblocks them at once
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	host, _, _ := strings.Cut(t.Addr, ":")
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return contextError(ctx, err)
+	}
+	defer client.Close()
+
+	if err := t.send(client, host, message.To, raw); err != nil {
+		return contextError(ctx, err)
+	}
+	return nil
+}
+
+// send runs the exchange smtp.SendMail would, on an open client
+func (t *SMTPTransport) send(client *smtp.Client, host, to string, raw []byte) error {
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if t.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support authentication")
+		}
+		if err := client.Auth(smtp.PlainAuth("", t.Username, t.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(t.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(raw); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// FileTransport writes each email as an .eml file, for local testing
+type FileTransport struct {
+	Dir  string
+	From string
+}
+
+func (t *FileTransport) Send(ctx context.Context, message *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	raw, err := buildMIME(t.From, message, now)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.Dir, 0o755); err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	name := now.UTC().Format("20060102T150405.000000000") + "-" + hex.EncodeToString(suffix) + ".eml"
+	return os.WriteFile(filepath.Join(t.Dir, name), raw, 0o644)
+}
+
+// LogTransport only logs emails, for development without a mail server
+type LogTransport struct{}
+
+func (t *LogTransport) Send(ctx context.Context, message *Message) error {
+	log.Info("email", "to", message.To, "subject", message.Subject, "text", message.Text)
+	return nil
+}
+
+// NewTransport creates a transport by name: smtp, file or log
+func NewTransport(name, from, smtpAddr, smtpUsername, smtpPassword, fileDir string) (Transport, error) {
+	switch name {
+	case "smtp":
+		if smtpAddr == "" {
+			return nil, errors.New("smtp transport needs an address")
+		}
+		return &SMTPTransport{Addr: smtpAddr, Username: smtpUsername, Password: smtpPassword, From: from}, nil
+	case "file":
+		return &FileTransport{Dir: fileDir, From: from}, nil
+	case "log":
+		return &LogTransport{}, nil
+	default:
+		return nil, errors.New("unknown email transport: " + name)
+	}
+}
+
+// Private helper methods
+
+// contextError reports a cancelled or expired context instead of the I/O
+// error it caused
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+// buildMIME renders a multipart/alternative message with text and HTML parts
+func buildMIME(from string, message *Message, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	domain := "localhost"
+	if _, host, ok := strings.Cut(from, "@"); ok {
+		domain = strings.TrimSuffix(host, ">")
+	}
+
+	headers := []string{
+		"From: " + from,
+		"To: " + message.To,
+		"Subject: " + mime.QEncoding.Encode("utf-8", message.Subject),
+		"Date: " + date.Format(time.RFC1123Z),
+		"Message-ID: <" + hex.EncodeToString(id) + "@" + domain + ">",
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + strconv.Quote(writer.Boundary()),
+	}
+	if message.UnsubscribeURL != "" {
+		// RFC 8058 one-click unsubscribe
+		headers = append(headers,
+			"List-Unsubscribe: <"+message.UnsubscribeURL+">",
+			"List-Unsubscribe-Post: List-Unsubscribe=One-Click",
+		)
+	}
+	for _, header := range headers {
+		buf.WriteString(header + "\r\n")
+	}
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", message.Text},
+		{"text/html; charset=utf-8", message.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err := encoder.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,136 @@
+package events
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// AccessChecker decides whether a user may see a project or company. Entities
+// that no longer exist are not accessible.
+type AccessChecker interface {
+	CanAccessProject(userID string, projectID uint) (bool, error)
+	CanAccessCompany(userID, companyID string) (bool, error)
+}
+
+// accessCacheTTL bounds how long a stream trusts an access decision, 30
+// seconds. Changes to the user's own company, team or project membership
+// clear the cache, and moves, owner changes and team grants drop the
+// project's entry. Sub-projects that inherit access from a changed parent
+// keep theirs until the TTL runs out; unlike the collaboration hub, which
+// re-checks the whole project tree, the filter does not walk the tree.
+const accessCacheTTL = 30 * time.Second
+
+type accessEntry struct {
+	allowed   bool
+	checkedAt time.Time
+}
+
+// Filter decides which events one user's stream receives. It caches access
+// decisions and is not safe for concurrent use.
+type Filter struct {
+	userID      string
+	access      AccessChecker
+	entityTypes []string // Empty for all
+	cache       map[string]accessEntry
+}
+
+func NewFilter(userID string, access AccessChecker, entityTypes []string) *Filter {
+	return &Filter{
+		userID:      userID,
+		access:      access,
+		entityTypes: entityTypes,
+		cache:       make(map[string]accessEntry),
+	}
+}
+
+// Visible reports whether the user may receive the event. Events must be
+// passed in order, including the ones that are not sent, so that membership
+// changes invalidate earlier decisions.
+func (f *Filter) Visible(event *db.ChangeEvent) (bool, error) {
+	f.invalidate(event)
+
+	if len(f.entityTypes) > 0 && !slices.Contains(f.entityTypes, event.EntityType) {
+		return false, nil
+	}
+	// Users always see their own changes and changes to their membership
+	if event.ActorID == f.userID || event.UserID == f.userID {
+		return true, nil
+	}
+
+	switch event.EntityType {
+	case EntityProject:
+		if event.ProjectID == nil {
+			return false, nil
+		}
+		key := projectKey(*event.ProjectID)
+		if event.Action == ActionDeleted {
+			// The project is gone, so rely on what was known before, then on the company
+			entry, ok := f.cache[key]
+			delete(f.cache, key)
+			if ok && entry.allowed {
+				return true, nil
+			}
+			if event.CompanyID == nil {
+				return false, nil
+			}
+			return f.check(companyKey(*event.CompanyID), func() (bool, error) {
+				return f.access.CanAccessCompany(f.userID, *event.CompanyID)
+			})
+		}
+		return f.check(key, func() (bool, error) {
+			return f.access.CanAccessProject(f.userID, *event.ProjectID)
+		})
+	case EntityCompany:
+		if event.CompanyID == nil {
+			return false, nil
+		}
+		key := companyKey(*event.CompanyID)
+		if event.Action == ActionDeleted {
+			entry, ok := f.cache[key]
+			delete(f.cache, key)
+			return ok && entry.allowed, nil
+		}
+		return f.check(key, func() (bool, error) {
+			return f.access.CanAccessCompany(f.userID, *event.CompanyID)
+		})
+	}
+	return false, nil
+}
+
+// Private helper methods
+
+// invalidate drops cached decisions the event may have changed
+func (f *Filter) invalidate(event *db.ChangeEvent) {
+	switch {
+	case event.UserID == f.userID && event.UserID != "":
+		// The user's own membership changed, and company membership also
+		// grants access to the company's projects
+		clear(f.cache)
+	case event.EntityType == EntityProject && event.ProjectID != nil &&
+		(event.Action == ActionMoved || event.Action == ActionOwnerChanged || event.Action == ActionTeamAdded || event.Action == ActionTeamRemoved):
+		delete(f.cache, projectKey(*event.ProjectID))
+	}
+}
+
+func (f *Filter) check(key string, checkAccess func() (bool, error)) (bool, error) {
+	if entry, ok := f.cache[key]; ok && time.Since(entry.checkedAt) < accessCacheTTL {
+		return entry.allowed, nil
+	}
+	allowed, err := checkAccess()
+	if err != nil {
+		return false, err
+	}
+	f.cache[key] = accessEntry{allowed: allowed, checkedAt: time.Now()}
+	return allowed, nil
+}
+
+func projectKey(id uint) string {
+	return "project:" + strconv.FormatUint(uint64(id), 10)
+}
+
+func companyKey(id string) string {
+	return "company:" + id
+}
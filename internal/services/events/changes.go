@@ -0,0 +1,82 @@
+package events
+
+import (
+	"strconv"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// ProjectEvent describes a change to a project, with the project as payload
+func ProjectEvent(action string, project *db.BaseProject, actorID string) db.ChangeEvent {
+	return db.ChangeEvent{
+		EntityType: EntityProject,
+		Action:     action,
+		ProjectID:  &project.ID,
+		CompanyID:  project.CompanyID,
+		ActorID:    actorID,
+		Payload:    project,
+	}
+}
+
+// ProjectMemberEvent describes a change to a project's members
+func ProjectMemberEvent(action string, project *db.BaseProject, member *db.ProjectMember, actorID string) db.ChangeEvent {
+	event := ProjectEvent(action, project, actorID)
+	event.UserID = member.UserID
+	event.Payload = member
+	return event
+}
+
+// ProjectTeamEvent describes a team gaining or losing access to a project
+func ProjectTeamEvent(action string, project *db.BaseProject, grant *db.ProjectTeam, actorID string) db.ChangeEvent {
+	event := ProjectEvent(action, project, actorID)
+	event.Payload = grant
+	return event
+}
+
+// CompanyEvent describes a change to a company. Members are left out of the
+// payload; they have their own events.
+func CompanyEvent(action string, company *db.Company, actorID string) db.ChangeEvent {
+	snapshot := *company
+	snapshot.Members = nil
+	return db.ChangeEvent{
+		EntityType: EntityCompany,
+		Action:     action,
+		CompanyID:  &company.ID,
+		ActorID:    actorID,
+		Payload:    snapshot,
+	}
+}
+
+// CompanyMemberEvent describes a change to a company's members. Compensation
+// is never part of the payload.
+func CompanyMemberEvent(action string, member *db.CompanyMember, actorID string) db.ChangeEvent {
+	return db.ChangeEvent{
+		EntityType: EntityCompany,
+		Action:     action,
+		CompanyID:  &member.CompanyID,
+		ActorID:    actorID,
+		UserID:     member.UserID,
+		Payload: map[string]string{
+			"memberId": strconv.FormatUint(uint64(member.ID), 10),
+			"userId":   member.UserID,
+			"role":     member.Role,
+			"status":   member.Status,
+		},
+	}
+}
+
+// TeamMemberEvent describes a user joining or leaving a company team, which
+// changes the projects the team's grants give them
+func TeamMemberEvent(action string, team *db.Team, member *db.TeamMember, actorID string) db.ChangeEvent {
+	return db.ChangeEvent{
+		EntityType: EntityCompany,
+		Action:     action,
+		CompanyID:  &team.CompanyID,
+		ActorID:    actorID,
+		UserID:     member.UserID,
+		Payload: map[string]string{
+			"teamId": strconv.FormatUint(uint64(team.ID), 10),
+			"userId": member.UserID,
+		},
+	}
+}
@@ -0,0 +1,253 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/pubsub"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "EventService"),
+)
+
+// Entity types
+const (
+	EntityProject = "project"
+	EntityCompany = "company"
+)
+
+// EntityTypes lists the entity types a stream can be limited to
+var EntityTypes = []string{EntityProject, EntityCompany}
+
+// Actions
+const (
+	ActionCreated       = "created"
+	ActionUpdated       = "updated"
+	ActionMoved         = "moved" // Company or parent changed
+	ActionOwnerChanged  = "owner_changed"
+	ActionDeleted       = "deleted"
+	ActionMemberAdded   = "member_added"
+	ActionMemberUpdated = "member_updated"
+	ActionMemberRemoved = "member_removed"
+	ActionMemberInvited = "member_invited"
+	ActionTeamAdded     = "team_added"
+	ActionTeamRemoved   = "team_removed"
+
+	ActionTeamMemberAdded   = "team_member_added"
+	ActionTeamMemberRemoved = "team_member_removed"
+)
+
+// Channel announces the ID of every recorded event to all replicas
+const Channel = "project_core_events"
+
+// Stream settings
+const (
+	DefaultRetention = 7 * 24 * time.Hour
+	// Replicas are woken through Channel; polling only covers lost notifications
+	DefaultPollInterval = 30 * time.Second
+	HeartbeatInterval   = 15 * time.Second
+	streamBatchSize     = 100
+	// A missing ID is usually an insert that has not committed yet. Readers
+	// wait for it this long before treating it as rolled back.
+	gapTimeout       = 5 * time.Second
+	gapRetryInterval = 250 * time.Millisecond
+)
+
+type EventService struct {
+	database  *pgconnect.DB
+	pubsub    *pubsub.PubSub
+	eventRepo *pgconnect.Repository[db.ChangeEvent]
+
+	mu       sync.Mutex
+	latestID int64
+	changed  chan struct{} // Closed and replaced when a newer event is seen
+}
+
+func NewEventService(database *pgconnect.DB, ps *pubsub.PubSub) *EventService {
+	s := &EventService{
+		database:  database,
+		pubsub:    ps,
+		eventRepo: pgconnect.NewRepository[db.ChangeEvent](database),
+		changed:   make(chan struct{}),
+	}
+
+	ps.Subscribe(Channel, func(payload string) {
+		if id, err := strconv.ParseInt(payload, 10, 64); err == nil {
+			s.advance(id)
+		}
+	})
+	ps.OnReconnect(s.poll)
+
+	return s
+}
+
+// Record appends an event to the log and wakes the streams of every replica
+func (s *EventService) Record(event *db.ChangeEvent) error {
+	event.CreatedAt = time.Now()
+	if err := s.eventRepo.Create(event); err != nil {
+		return err
+	}
+	s.advance(event.ID)
+
+	// The event is saved; other replicas also find it by polling
+	if err := s.pubsub.Publish(context.Background(), Channel, strconv.FormatInt(event.ID, 10)); err != nil {
+		log.Error("publish:failed", "eventID", event.ID, "error", err)
+	}
+	return nil
+}
+
+// Changed returns a channel that is closed once an event newer than the ones
+// seen so far is recorded. Take it before reading so no event is missed.
+func (s *EventService) Changed() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.changed
+}
+
+// Watch polls for events whose notification was lost until ctx ends
+func (s *EventService) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.poll()
+		}
+	}
+}
+
+func (s *EventService) LatestID() (int64, error) {
+	var id int64
+	err := s.database.Model(&db.ChangeEvent{}).Select("COALESCE(MAX(id), 0)").Scan(&id).Error
+	return id, err
+}
+
+// Cursor returns the ID a stream starts after. New streams (lastID nil)
+// start at the newest event. expired reports that events after lastID were
+// already pruned, or the log was reset, so the client missed changes; the
+// stream then starts at the newest event.
+func (s *EventService) Cursor(lastID *int64) (cursor int64, expired bool, err error) {
+	latestID, err := s.LatestID()
+	if err != nil {
+		return 0, false, err
+	}
+	if lastID == nil {
+		return latestID, false, nil
+	}
+
+	var oldestID int64
+	if err := s.database.Model(&db.ChangeEvent{}).Select("COALESCE(MIN(id), 0)").Scan(&oldestID).Error; err != nil {
+		return 0, false, err
+	}
+	if *lastID > latestID || (oldestID > 0 && *lastID < oldestID-1) {
+		return latestID, true, nil
+	}
+	return *lastID, false, nil
+}
+
+// ReadAfter returns up to limit events after afterID, in order. It stops
+// early at a gap in the sequence left by an insert that may still commit,
+// and reports it so the caller retries shortly.
+func (s *EventService) ReadAfter(afterID int64, limit int) (events []db.ChangeEvent, gap bool, err error) {
+	if err := s.database.Where("id > ?", afterID).Order("id").Limit(limit).Find(&events).Error; err != nil {
+		return nil, false, err
+	}
+
+	expected := afterID + 1
+	for i, event := range events {
+		if event.ID != expected && time.Since(event.CreatedAt) < gapTimeout {
+			return events[:i], true, nil
+		}
+		expected = event.ID + 1
+	}
+	return events, false, nil
+}
+
+// Stream sends the events after afterID that filter lets through, as they
+// are recorded, until ctx ends or a callback fails. A nil filter passes
+// every event. heartbeat is called every HeartbeatInterval to keep idle
+// connections open.
+func (s *EventService) Stream(ctx context.Context, afterID int64, filter *Filter, send func(event *db.ChangeEvent) error, heartbeat func() error) error {
+	heartbeatTicker := time.NewTicker(HeartbeatInterval)
+	defer heartbeatTicker.Stop()
+
+	for {
+		changed := s.Changed()
+		events, gap, err := s.ReadAfter(afterID, streamBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range events {
+			visible := true
+			if filter != nil {
+				visible, err = filter.Visible(&events[i])
+				if err != nil {
+					return err
+				}
+			}
+			if visible {
+				if err := send(&events[i]); err != nil {
+					return err
+				}
+			}
+			afterID = events[i].ID
+		}
+		if len(events) == streamBatchSize {
+			continue
+		}
+
+		var retry <-chan time.Time
+		if gap {
+			retry = time.After(gapRetryInterval)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-retry:
+		case <-heartbeatTicker.C:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Prune deletes events older than retention. Streams resuming from a pruned
+// event are told to reload instead.
+func (s *EventService) Prune(ctx context.Context, retention time.Duration) (int64, error) {
+	result := s.database.WithContext(ctx).Where("created_at < ?", time.Now().Add(-retention)).Delete(&db.ChangeEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// Private helper methods
+
+func (s *EventService) poll() {
+	latestID, err := s.LatestID()
+	if err != nil {
+		log.Error("poll:failed", "error", err)
+		return
+	}
+	s.advance(latestID)
+}
+
+func (s *EventService) advance(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id > s.latestID {
+		s.latestID = id
+		close(s.changed)
+		s.changed = make(chan struct{})
+	}
+}
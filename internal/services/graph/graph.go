@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "GraphService"),
+)
+
+// GraphService reads projects, companies and their members in batches, one
+// query per relationship for any number of parents. It does not check
+// access; callers decide what the user may see.
+type GraphService struct {
+	database          *pgconnect.DB
+	projectRepo       *pgconnect.Repository[db.BaseProject]
+	memberRepo        *pgconnect.Repository[db.ProjectMember]
+	companyRepo       *pgconnect.Repository[db.Company]
+	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+}
+
+func NewGraphService(database *pgconnect.DB) *GraphService {
+	return &GraphService{
+		database:          database,
+		projectRepo:       pgconnect.NewRepository[db.BaseProject](database),
+		memberRepo:        pgconnect.NewRepository[db.ProjectMember](database),
+		companyRepo:       pgconnect.NewRepository[db.Company](database),
+		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+	}
+}
+
+func (s *GraphService) ProjectsByID(ids []uint) (map[uint]db.BaseProject, error) {
+	log.Debug("projects-by-id", "count", len(ids))
+	var projects []db.BaseProject
+	if err := s.projectRepo.FindWhere(&projects, "id IN ?", ids); err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint]db.BaseProject, len(projects))
+	for _, project := range projects {
+		result[project.ID] = project
+	}
+	return result, nil
+}
+
+// ProjectsByParent returns the direct sub-projects of each parent
+func (s *GraphService) ProjectsByParent(parentIDs []uint) (map[uint][]db.BaseProject, error) {
+	log.Debug("projects-by-parent", "count", len(parentIDs))
+	var projects []db.BaseProject
+	if err := s.database.Where("parent_id IN ?", parentIDs).Order("id").Find(&projects).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]db.BaseProject, len(parentIDs))
+	for _, project := range projects {
+		result[*project.ParentID] = append(result[*project.ParentID], project)
+	}
+	return result, nil
+}
+
+func (s *GraphService) ProjectsByCompany(companyIDs []string) (map[string][]db.BaseProject, error) {
+	log.Debug("projects-by-company", "count", len(companyIDs))
+	var projects []db.BaseProject
+	if err := s.database.Where("company_id IN ?", companyIDs).Order("id").Find(&projects).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]db.BaseProject, len(companyIDs))
+	for _, project := range projects {
+		result[*project.CompanyID] = append(result[*project.CompanyID], project)
+	}
+	return result, nil
+}
+
+// MembersByProject returns the core members of each project
+func (s *GraphService) MembersByProject(projectIDs []uint) (map[uint][]db.ProjectMember, error) {
+	log.Debug("members-by-project", "count", len(projectIDs))
+	keys := make([]string, len(projectIDs))
+	for i, id := range projectIDs {
+		keys[i] = strconv.Itoa(int(id))
+	}
+
+	var members []db.ProjectMember
+	if err := s.database.Where("project_id IN ? AND project_type = ?", keys, "core").Order("joined_at").Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]db.ProjectMember, len(projectIDs))
+	for _, member := range members {
+		projectID, err := strconv.ParseUint(member.ProjectID, 10, 32)
+		if err != nil {
+			continue
+		}
+		result[uint(projectID)] = append(result[uint(projectID)], member)
+	}
+	return result, nil
+}
+
+func (s *GraphService) CompaniesByID(ids []string) (map[string]db.Company, error) {
+	log.Debug("companies-by-id", "count", len(ids))
+	var companies []db.Company
+	if err := s.companyRepo.FindWhere(&companies, "id IN ?", ids); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]db.Company, len(companies))
+	for _, company := range companies {
+		result[company.ID] = company
+	}
+	return result, nil
+}
+
+func (s *GraphService) CompanyMembersByCompany(companyIDs []string) (map[string][]db.CompanyMember, error) {
+	log.Debug("company-members-by-company", "count", len(companyIDs))
+	var members []db.CompanyMember
+	if err := s.database.Where("company_id IN ?", companyIDs).Order("id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]db.CompanyMember, len(companyIDs))
+	for _, member := range members {
+		result[member.CompanyID] = append(result[member.CompanyID], member)
+	}
+	return result, nil
+}
+
+// CompanyMembersByUser returns every company membership of each user
+func (s *GraphService) CompanyMembersByUser(userIDs []string) (map[string][]db.CompanyMember, error) {
+	log.Debug("company-members-by-user", "count", len(userIDs))
+	var members []db.CompanyMember
+	if err := s.database.Where("user_id IN ?", userIDs).Order("id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]db.CompanyMember, len(userIDs))
+	for _, member := range members {
+		result[member.UserID] = append(result[member.UserID], member)
+	}
+	return result, nil
+}
+
+// ActiveCompanyIDs returns the companies the user is an active member of,
+// which are the companies the user can access
+func (s *GraphService) ActiveCompanyIDs(userID string) (map[string]bool, error) {
+	var companyIDs []string
+	if err := s.database.Model(&db.CompanyMember{}).
+		Where("user_id = ? AND status = ?", userID, "active").
+		Pluck("company_id", &companyIDs).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool, len(companyIDs))
+	for _, id := range companyIDs {
+		result[id] = true
+	}
+	return result, nil
+}
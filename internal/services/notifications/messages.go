@@ -0,0 +1,127 @@
+package notifications
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+// The builders below keep notification wording in one place. Data holds the
+// values used in the text, so other channels can render their own messages.
+
+func ProjectMemberAdded(project *db.BaseProject, role string) db.Notification {
+	return db.Notification{
+		Type:      TypeMemberAdded,
+		Title:     "Added to project",
+		Body:      "You were added to " + project.Title + " as " + role + ".",
+		Data:      map[string]string{"project": project.Title, "role": role},
+		ProjectID: &project.ID,
+		CompanyID: project.CompanyID,
+	}
+}
+
+func CompanyMemberAdded(company *db.Company, member *db.CompanyMember) db.Notification {
+	return db.Notification{
+		Type:      TypeMemberAdded,
+		Title:     "Added to company",
+		Body:      "You were added to " + company.Name + " as " + member.Role + ".",
+		Data:      map[string]string{"company": company.Name, "role": member.Role},
+		CompanyID: &company.ID,
+	}
+}
+
+func InvitationReceived(company *db.Company, member *db.CompanyMember) db.Notification {
+	return db.Notification{
+		Type:      TypeInvitationReceived,
+		Title:     "Company invitation",
+		Body:      "You were invited to join " + company.Name + " as " + member.Role + ".",
+		Data:      map[string]string{"company": company.Name, "role": member.Role, "invitedBy": member.InvitedBy},
+		CompanyID: &company.ID,
+	}
+}
+
+func ProjectRoleChanged(project *db.BaseProject, role string) db.Notification {
+	return db.Notification{
+		Type:      TypeRoleChanged,
+		Title:     "Project role changed",
+		Body:      "Your role in " + project.Title + " is now " + role + ".",
+		Data:      map[string]string{"project": project.Title, "role": role},
+		ProjectID: &project.ID,
+		CompanyID: project.CompanyID,
+	}
+}
+
+func CompanyRoleChanged(company *db.Company, member *db.CompanyMember) db.Notification {
+	return db.Notification{
+		Type:      TypeRoleChanged,
+		Title:     "Company role changed",
+		Body:      "Your role in " + company.Name + " is now " + member.Role + ".",
+		Data:      map[string]string{"company": company.Name, "role": member.Role},
+		CompanyID: &company.ID,
+	}
+}
+
+func ProjectStatusChanged(project *db.BaseProject, oldStatus string) db.Notification {
+	return db.Notification{
+		Type:      TypeProjectStatusChanged,
+		Title:     "Project status changed",
+		Body:      project.Title + " changed from " + oldStatus + " to " + project.Status + ".",
+		Data:      map[string]string{"project": project.Title, "oldStatus": oldStatus, "status": project.Status},
+		ProjectID: &project.ID,
+		CompanyID: project.CompanyID,
+	}
+}
+
+// DeadlineApproaching is deduplicated per lead time and end date, so moving
+// a deadline re-arms its reminders
+func DeadlineApproaching(project *db.BaseProject, leadTime time.Duration) db.Notification {
+	due := project.EndDate.Format("2006-01-02")
+	dedupKey := "deadline:" + strconv.FormatUint(uint64(project.ID), 10) + ":" + leadTime.String() + ":" + strconv.FormatInt(project.EndDate.Unix(), 10)
+	return db.Notification{
+		Type:      TypeDeadlineApproaching,
+		Title:     "Project deadline approaching",
+		Body:      project.Title + " is due on " + due + " (within " + FormatLeadTime(leadTime) + ").",
+		Data:      map[string]string{"project": project.Title, "due": due, "within": FormatLeadTime(leadTime), "withinDays": wholeDays(leadTime)},
+		ProjectID: &project.ID,
+		CompanyID: project.CompanyID,
+		DedupKey:  &dedupKey,
+	}
+}
+
+func ProjectOverdue(project *db.BaseProject) db.Notification {
+	due := project.EndDate.Format("2006-01-02")
+	dedupKey := "overdue:" + strconv.FormatUint(uint64(project.ID), 10) + ":" + strconv.FormatInt(project.EndDate.Unix(), 10)
+	return db.Notification{
+		Type:      TypeProjectOverdue,
+		Title:     "Project overdue",
+		Body:      project.Title + " was due on " + due + " and is still active.",
+		Data:      map[string]string{"project": project.Title, "due": due},
+		ProjectID: &project.ID,
+		CompanyID: project.CompanyID,
+		DedupKey:  &dedupKey,
+	}
+}
+
+// FormatLeadTime renders whole days as "N days" and anything else as a duration
+func FormatLeadTime(leadTime time.Duration) string {
+	day := 24 * time.Hour
+	switch {
+	case leadTime == day:
+		return "1 day"
+	case leadTime%day == 0:
+		return strconv.Itoa(int(leadTime/day)) + " days"
+	default:
+		return leadTime.String()
+	}
+}
+
+// wholeDays returns the lead time in days, or "" when it isn't whole days,
+// so translations can word the number themselves
+func wholeDays(leadTime time.Duration) string {
+	day := 24 * time.Hour
+	if leadTime%day != 0 {
+		return ""
+	}
+	return strconv.Itoa(int(leadTime / day))
+}
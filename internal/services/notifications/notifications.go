@@ -0,0 +1,265 @@
+package notifications
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm/clause"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "NotificationService"),
+)
+
+// Notification types
+const (
+	TypeMemberAdded          = "member_added"
+	TypeRoleChanged          = "role_changed"
+	TypeInvitationReceived   = "invitation_received"
+	TypeProjectStatusChanged = "project_status_changed"
+	TypeDeadlineApproaching  = "deadline_approaching"
+	TypeProjectOverdue       = "project_overdue"
+)
+
+// Types lists every notification type users can set a preference for
+var Types = []string{
+	TypeMemberAdded,
+	TypeRoleChanged,
+	TypeInvitationReceived,
+	TypeProjectStatusChanged,
+	TypeDeadlineApproaching,
+	TypeProjectOverdue,
+}
+
+// DefaultPageSize and MaxPageSize bound inbox listings
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+type ListOptions struct {
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+// Channel delivers stored notifications outside the app, such as by email
+type Channel interface {
+	Deliver(notification *db.Notification) error
+}
+
+type NotificationService struct {
+	database         *pgconnect.DB
+	notificationRepo *pgconnect.Repository[db.Notification]
+	preferenceRepo   *pgconnect.Repository[db.NotificationPreference]
+	channels         []Channel
+}
+
+func NewNotificationService(database *pgconnect.DB) *NotificationService {
+	return &NotificationService{
+		database:         database,
+		notificationRepo: pgconnect.NewRepository[db.Notification](database),
+		preferenceRepo:   pgconnect.NewRepository[db.NotificationPreference](database),
+	}
+}
+
+// AddChannel delivers every new notification through channel as well.
+// Channels must be added before the service is used.
+func (s *NotificationService) AddChannel(channel Channel) {
+	s.channels = append(s.channels, channel)
+}
+
+// Notify stores a notification for every user who hasn't turned its type off
+// and hands it to each channel. When the notification has a dedup key, users
+// who already received one with the same key are skipped. It returns the
+// number of notifications created.
+func (s *NotificationService) Notify(userIDs []string, notification db.Notification) (int, error) {
+	recipients, err := s.filterRecipients(uniqueUsers(userIDs), notification.Type)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	now := time.Now()
+	for _, userID := range recipients {
+		n := notification
+		n.ID = 0
+		n.UserID = userID
+		n.CreatedAt = now
+		n.ReadAt = nil
+
+		// The unique (user_id, dedup_key) index makes this safe across replicas
+		result := s.database.Clauses(clause.OnConflict{DoNothing: true}).Create(&n)
+		if result.Error != nil {
+			return created, result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		created++
+
+		// The notification is already stored, so channel failures are only logged
+		for _, channel := range s.channels {
+			if err := channel.Deliver(&n); err != nil {
+				log.Error("deliver:failed", "type", n.Type, "notificationID", n.ID, "error", err)
+			}
+		}
+	}
+	return created, nil
+}
+
+// GetNotifications lists a user's notifications, newest first
+func (s *NotificationService) GetNotifications(userID string, options ListOptions) ([]db.Notification, error) {
+	if options.Limit <= 0 {
+		options.Limit = DefaultPageSize
+	}
+	if options.Limit > MaxPageSize {
+		options.Limit = MaxPageSize
+	}
+	if options.Offset < 0 {
+		options.Offset = 0
+	}
+
+	query := s.database.Where("user_id = ?", userID)
+	if options.UnreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var notifications []db.Notification
+	err := query.Order("created_at DESC, id DESC").Limit(options.Limit).Offset(options.Offset).Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (s *NotificationService) CountUnread(userID string) (int64, error) {
+	var count int64
+	if err := s.notificationRepo.Count(&count, "user_id = ? AND read_at IS NULL", userID); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *NotificationService) MarkRead(id uint, userID string) (*db.Notification, error) {
+	var notification db.Notification
+	if err := s.notificationRepo.FindByID(id, &notification); err != nil {
+		return nil, err
+	}
+	if notification.UserID != userID {
+		return nil, errors.New("user cannot access this notification")
+	}
+	if notification.ReadAt != nil {
+		return &notification, nil
+	}
+
+	now := time.Now()
+	notification.ReadAt = &now
+	if err := s.notificationRepo.Update(&notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// MarkAllRead marks every unread notification of the user as read and
+// returns how many were changed
+func (s *NotificationService) MarkAllRead(userID string) (int64, error) {
+	result := s.database.Model(&db.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	return result.RowsAffected, result.Error
+}
+
+// GetPreferences returns whether each notification type is enabled for the user
+func (s *NotificationService) GetPreferences(userID string) (map[string]bool, error) {
+	var stored []db.NotificationPreference
+	if err := s.preferenceRepo.FindWhere(&stored, "user_id = ?", userID); err != nil {
+		return nil, err
+	}
+
+	preferences := make(map[string]bool, len(Types))
+	for _, notificationType := range Types {
+		preferences[notificationType] = true
+	}
+	for _, preference := range stored {
+		if _, ok := preferences[preference.Type]; ok {
+			preferences[preference.Type] = preference.Enabled
+		}
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences changes the given types and leaves the others as they are
+func (s *NotificationService) UpdatePreferences(userID string, changes map[string]bool) (map[string]bool, error) {
+	for notificationType := range changes {
+		if !slices.Contains(Types, notificationType) {
+			return nil, errors.New("invalid notification type")
+		}
+	}
+
+	now := time.Now()
+	for notificationType, enabled := range changes {
+		preference := &db.NotificationPreference{
+			UserID:    userID,
+			Type:      notificationType,
+			Enabled:   enabled,
+			UpdatedAt: now,
+		}
+		err := s.database.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+		}).Create(preference).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetPreferences(userID)
+}
+
+// Private helper methods
+
+// filterRecipients drops users who turned the notification type off
+func (s *NotificationService) filterRecipients(userIDs []string, notificationType string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	var disabled []db.NotificationPreference
+	if err := s.preferenceRepo.FindWhere(&disabled, "user_id IN ? AND type = ? AND enabled = ?", userIDs, notificationType, false); err != nil {
+		return nil, err
+	}
+	if len(disabled) == 0 {
+		return userIDs, nil
+	}
+
+	optedOut := make(map[string]bool, len(disabled))
+	for _, preference := range disabled {
+		optedOut[preference.UserID] = true
+	}
+	recipients := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if !optedOut[userID] {
+			recipients = append(recipients, userID)
+		}
+	}
+	return recipients, nil
+}
+
+func uniqueUsers(userIDs []string) []string {
+	seen := make(map[string]bool, len(userIDs))
+	unique := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == "" || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		unique = append(unique, userID)
+	}
+	return unique
+}
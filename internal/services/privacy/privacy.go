@@ -0,0 +1,435 @@
+package privacy
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+// Policies for the personal projects of an erased user. Company projects
+// always go to the company owner, since they belong to the company.
+const (
+	PersonalProjectsDelete    = "delete"
+	PersonalProjectsAnonymize = "anonymize"
+)
+
+type PrivacyService struct {
+	database   *pgconnect.DB
+	recordRepo *pgconnect.Repository[db.ErasureRecord]
+}
+
+func NewPrivacyService(database *pgconnect.DB) *PrivacyService {
+	return &PrivacyService{
+		database:   database,
+		recordRepo: pgconnect.NewRepository[db.ErasureRecord](database),
+	}
+}
+
+// SubjectAccessReport lists everything stored under a user ID
+type SubjectAccessReport struct {
+	UserID              string
+	GeneratedAt         time.Time
+	OwnedCompanies      []db.Company
+	CompanyMemberships  []db.CompanyMember
+	InvitationsSent     []db.CompanyMember
+	CompensationHistory []db.CompensationRate
+	OwnedProjects       []db.BaseProject
+	ProjectMemberships  []db.ProjectMember
+	TeamMemberships     []db.TeamMember
+	TeamsCreated        []db.Team
+	OwnedTemplates      []db.ProjectTemplate
+	TemplateMemberships []db.ProjectTemplateMember
+	OwnedTags           []db.Tag
+	CalendarTokens      []db.CalendarToken
+	Notifications       []db.Notification
+	NotificationPrefs   []db.NotificationPreference
+	EmailSettings       []db.EmailSettings
+	EmailMessages       []db.EmailMessage
+	ChangeEvents        []db.ChangeEvent
+	ProjectPresence     []db.ProjectPresence
+}
+
+type ErasurePolicy struct {
+	PersonalProjects   string `json:"personalProjects"`   // delete (default) or anonymize
+	RetainCompensation bool   `json:"retainCompensation"` // Keep pay history for accounting under the pseudonym
+}
+
+// ErasureAction is one step of an erasure: what happened to which column
+type ErasureAction struct {
+	Table  string `json:"table"`
+	Column string `json:"column"`
+	Action string `json:"action"` // deleted, anonymized, reassigned or detached
+	Count  int64  `json:"count"`
+}
+
+type ErasureResult struct {
+	DryRun  bool
+	Actions []ErasureAction
+	Record  *db.ErasureRecord // nil on dry runs
+}
+
+func (s *PrivacyService) GetSubjectAccessReport(subjectID, requestingUserID string) (*SubjectAccessReport, error) {
+	if subjectID != requestingUserID {
+		return nil, errors.New("users can only access their own data")
+	}
+
+	report := &SubjectAccessReport{UserID: subjectID, GeneratedAt: time.Now()}
+	queries := []struct {
+		dest  interface{}
+		query string
+	}{
+		{&report.OwnedCompanies, "owner_id = ?"},
+		{&report.CompanyMemberships, "user_id = ?"},
+		{&report.InvitationsSent, "invited_by = ? AND user_id <> invited_by"},
+		{&report.CompensationHistory, "user_id = ?"},
+		{&report.OwnedProjects, "owner_id = ?"},
+		{&report.ProjectMemberships, "user_id = ?"},
+		{&report.TeamMemberships, "user_id = ?"},
+		{&report.TeamsCreated, "created_by = ?"},
+		{&report.OwnedTemplates, "owner_id = ?"},
+		{&report.TemplateMemberships, "user_id = ?"},
+		{&report.OwnedTags, "owner_id = ?"},
+		{&report.CalendarTokens, "user_id = ?"},
+		{&report.Notifications, "user_id = ?"},
+		{&report.NotificationPrefs, "user_id = ?"},
+		{&report.EmailSettings, "user_id = ?"},
+		{&report.EmailMessages, "user_id = ?"},
+		{&report.ProjectPresence, "user_id = ?"},
+	}
+	for _, q := range queries {
+		if err := s.database.Where(q.query, subjectID).Find(q.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+	if err := s.database.Where("actor_id = ? OR user_id = ?", subjectID, subjectID).Find(&report.ChangeEvents).Error; err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// EraseUser removes or anonymizes every reference to a user in one
+// transaction and stores an ErasureRecord of what was done. Users who still
+// own companies must transfer or delete them first.
+func (s *PrivacyService) EraseUser(subjectID string, policy ErasurePolicy, dryRun bool, requestingUserID string) (*ErasureResult, error) {
+	if subjectID != requestingUserID {
+		return nil, errors.New("users can only erase their own data")
+	}
+	if policy.PersonalProjects == "" {
+		policy.PersonalProjects = PersonalProjectsDelete
+	}
+	if policy.PersonalProjects != PersonalProjectsDelete && policy.PersonalProjects != PersonalProjectsAnonymize {
+		return nil, errors.New("invalid personal projects policy")
+	}
+
+	var ownedCompanies int64
+	if err := s.database.Model(&db.Company{}).Where("owner_id = ?", subjectID).Count(&ownedCompanies).Error; err != nil {
+		return nil, err
+	}
+	if ownedCompanies > 0 {
+		return nil, errors.New("user still owns companies")
+	}
+
+	pseudonym, err := newPseudonym()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ErasureResult{DryRun: dryRun}
+	err = s.database.WithTransaction(func(tx *gorm.DB) error {
+		actions, err := erase(tx, subjectID, pseudonym, policy)
+		if err != nil {
+			return err
+		}
+		result.Actions = actions
+
+		if dryRun {
+			// Rolls back everything erase did; the counts are kept
+			return errDryRun
+		}
+
+		record, err := newErasureRecord(subjectID, pseudonym, policy, actions)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+		result.Record = record
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetErasureRecords finds the records for a user ID by its hash, so anyone
+// holding the ID can check that it was erased
+func (s *PrivacyService) GetErasureRecords(subjectID string) ([]db.ErasureRecord, error) {
+	var records []db.ErasureRecord
+	if err := s.recordRepo.FindWhere(&records, "subject_hash = ?", hashSubject(subjectID)); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// VerifyErasureRecord recomputes the digest of a stored record
+func (s *PrivacyService) VerifyErasureRecord(id uint) (*db.ErasureRecord, bool, error) {
+	var record db.ErasureRecord
+	if err := s.recordRepo.FindByID(id, &record); err != nil {
+		return nil, false, err
+	}
+	return &record, digestRecord(&record) == record.Digest, nil
+}
+
+// Private helper methods
+
+var errDryRun = errors.New("dry run")
+
+func erase(tx *gorm.DB, subjectID, pseudonym string, policy ErasurePolicy) ([]ErasureAction, error) {
+	var actions []ErasureAction
+	record := func(table, column, action string, result *gorm.DB) error {
+		if result.Error != nil {
+			return result.Error
+		}
+		actions = append(actions, ErasureAction{Table: table, Column: column, Action: action, Count: result.RowsAffected})
+		return nil
+	}
+
+	// Company projects stay with the company
+	reassigned := tx.Exec(`
+		UPDATE base_projects bp SET owner_id = c.owner_id, updated_at = ?
+		FROM companies c
+		WHERE bp.company_id = c.id AND bp.owner_id = ?`, time.Now(), subjectID)
+	if err := record("base_projects", "owner_id", "reassigned", reassigned); err != nil {
+		return nil, err
+	}
+	// Projects of a deleted company have no owner to take them over
+	if err := record("base_projects", "owner_id", "anonymized",
+		tx.Model(&db.BaseProject{}).Where("company_id IS NOT NULL AND owner_id = ?", subjectID).Update("owner_id", pseudonym)); err != nil {
+		return nil, err
+	}
+
+	// Personal projects
+	if policy.PersonalProjects == PersonalProjectsAnonymize {
+		if err := record("base_projects", "owner_id", "anonymized",
+			tx.Model(&db.BaseProject{}).Where("company_id IS NULL AND owner_id = ?", subjectID).Update("owner_id", pseudonym)); err != nil {
+			return nil, err
+		}
+	} else {
+		personal := tx.Model(&db.BaseProject{}).Select("id").Where("company_id IS NULL AND owner_id = ?", subjectID)
+		personalText := tx.Model(&db.BaseProject{}).Select("CAST(id AS TEXT)").Where("company_id IS NULL AND owner_id = ?", subjectID)
+
+		// Sub-projects owned by others survive as top-level projects
+		if err := record("base_projects", "parent_id", "detached",
+			tx.Model(&db.BaseProject{}).Where("parent_id IN (?) AND owner_id <> ?", personal, subjectID).Update("parent_id", nil)); err != nil {
+			return nil, err
+		}
+		if err := record("project_members", "project_id", "deleted",
+			tx.Where("project_type = ? AND project_id IN (?)", "core", personalText).Delete(&db.ProjectMember{})); err != nil {
+			return nil, err
+		}
+		if err := record("project_teams", "project_id", "deleted",
+			tx.Where("project_id IN (?)", personal).Delete(&db.ProjectTeam{})); err != nil {
+			return nil, err
+		}
+		if err := record("project_tags", "project_id", "deleted",
+			tx.Where("project_id IN (?)", personal).Delete(&db.ProjectTag{})); err != nil {
+			return nil, err
+		}
+		if err := record("base_projects", "owner_id", "deleted",
+			tx.Where("company_id IS NULL AND owner_id = ?", subjectID).Delete(&db.BaseProject{})); err != nil {
+			return nil, err
+		}
+	}
+
+	// Memberships
+	if err := record("project_members", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.ProjectMember{})); err != nil {
+		return nil, err
+	}
+	if err := record("team_members", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.TeamMember{})); err != nil {
+		return nil, err
+	}
+	if err := record("company_members", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.CompanyMember{})); err != nil {
+		return nil, err
+	}
+
+	// Compensation history
+	if policy.RetainCompensation {
+		if err := record("compensation_rates", "user_id", "anonymized",
+			tx.Model(&db.CompensationRate{}).Where("user_id = ?", subjectID).Update("user_id", pseudonym)); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := record("compensation_rates", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.CompensationRate{})); err != nil {
+			return nil, err
+		}
+	}
+
+	// Templates; company templates stay with the company like its projects
+	reassignedTemplates := tx.Exec(`
+		UPDATE project_templates pt SET owner_id = c.owner_id, updated_at = ?
+		FROM companies c
+		WHERE pt.company_id = c.id AND pt.owner_id = ?`, time.Now(), subjectID)
+	if err := record("project_templates", "owner_id", "reassigned", reassignedTemplates); err != nil {
+		return nil, err
+	}
+	if err := record("project_templates", "owner_id", "anonymized",
+		tx.Model(&db.ProjectTemplate{}).Where("company_id IS NOT NULL AND owner_id = ?", subjectID).Update("owner_id", pseudonym)); err != nil {
+		return nil, err
+	}
+	templates := tx.Model(&db.ProjectTemplate{}).Select("id").Where("company_id IS NULL AND owner_id = ?", subjectID)
+	if err := record("project_template_members", "template_id", "deleted",
+		tx.Where("template_id IN (?)", templates).Delete(&db.ProjectTemplateMember{})); err != nil {
+		return nil, err
+	}
+	if err := record("project_templates", "owner_id", "deleted", tx.Where("company_id IS NULL AND owner_id = ?", subjectID).Delete(&db.ProjectTemplate{})); err != nil {
+		return nil, err
+	}
+	if err := record("project_template_members", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.ProjectTemplateMember{})); err != nil {
+		return nil, err
+	}
+
+	// Personal tags; company tags stay with the company
+	personalTags := tx.Model(&db.Tag{}).Select("id").Where("company_id IS NULL AND owner_id = ?", subjectID)
+	if err := record("project_tags", "tag_id", "deleted",
+		tx.Where("tag_id IN (?)", personalTags).Delete(&db.ProjectTag{})); err != nil {
+		return nil, err
+	}
+	if err := record("tags", "owner_id", "deleted", tx.Where("company_id IS NULL AND owner_id = ?", subjectID).Delete(&db.Tag{})); err != nil {
+		return nil, err
+	}
+
+	// Calendar feeds
+	if err := record("calendar_tokens", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.CalendarToken{})); err != nil {
+		return nil, err
+	}
+
+	// Notifications
+	if err := record("notifications", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.Notification{})); err != nil {
+		return nil, err
+	}
+	if err := record("notification_preferences", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.NotificationPreference{})); err != nil {
+		return nil, err
+	}
+	if err := record("email_settings", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.EmailSettings{})); err != nil {
+		return nil, err
+	}
+	if err := record("email_messages", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.EmailMessage{})); err != nil {
+		return nil, err
+	}
+
+	if err := record("project_presences", "user_id", "deleted", tx.Where("user_id = ?", subjectID).Delete(&db.ProjectPresence{})); err != nil {
+		return nil, err
+	}
+
+	// Change events are short-lived, so any event naming the user is dropped
+	if err := record("change_events", "actor_id, user_id, payload", "deleted",
+		tx.Where("actor_id = @subject OR user_id = @subject OR payload->>'ownerId' = @subject OR payload->>'userId' = @subject OR payload->>'grantedBy' = @subject", sql.Named("subject", subjectID)).
+			Delete(&db.ChangeEvent{})); err != nil {
+		return nil, err
+	}
+
+	// References kept for other users' records
+	anonymize := []struct {
+		model  interface{}
+		table  string
+		column string
+	}{
+		{&db.CompanyMember{}, "company_members", "invited_by"},
+		{&db.CompensationRate{}, "compensation_rates", "set_by"},
+		{&db.Team{}, "teams", "created_by"},
+		{&db.ProjectTeam{}, "project_teams", "granted_by"},
+		{&db.CustomFieldDefinition{}, "custom_field_definitions", "created_by"},
+		{&db.Tag{}, "tags", "owner_id"},
+		{&db.ProjectTag{}, "project_tags", "tagged_by"},
+	}
+	for _, a := range anonymize {
+		if err := record(a.table, a.column, "anonymized",
+			tx.Model(a.model).Where(a.column+" = ?", subjectID).Update(a.column, pseudonym)); err != nil {
+			return nil, err
+		}
+	}
+	if err := record("notifications", "data.invitedBy", "anonymized",
+		tx.Exec("UPDATE notifications SET data = jsonb_set(data, '{invitedBy}', to_jsonb(CAST(? AS TEXT))) WHERE data->>'invitedBy' = ?", pseudonym, subjectID)); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+func newErasureRecord(subjectID, pseudonym string, policy ErasurePolicy, actions []ErasureAction) (*db.ErasureRecord, error) {
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	actionsJSON, err := json.Marshal(actions)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &db.ErasureRecord{
+		SubjectHash: hashSubject(subjectID),
+		Pseudonym:   pseudonym,
+		Policy:      string(policyJSON),
+		Actions:     string(actionsJSON),
+		PerformedAt: time.Now().UTC().Truncate(time.Microsecond), // Postgres precision, so the digest survives a round trip
+	}
+	record.Digest = digestRecord(record)
+	return record, nil
+}
+
+// digestRecord hashes the record fields in a fixed order. jsonb normalizes
+// whitespace and key order, so the JSON fields are re-encoded before hashing.
+func digestRecord(record *db.ErasureRecord) string {
+	hash := sha256.New()
+	for _, field := range []string{
+		record.SubjectHash,
+		record.Pseudonym,
+		canonicalJSON(record.Policy),
+		canonicalJSON(record.Actions),
+		strconv.FormatInt(record.PerformedAt.UnixMicro(), 10),
+	} {
+		hash.Write([]byte(field))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func canonicalJSON(value string) string {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+	// encoding/json sorts map keys, which gives a stable form
+	encoded, err := json.Marshal(decoded)
+	if err != nil {
+		return value
+	}
+	return string(encoded)
+}
+
+func hashSubject(subjectID string) string {
+	sum := sha256.Sum256([]byte(subjectID))
+	return hex.EncodeToString(sum[:])
+}
+
+// newPseudonym is random, so it cannot be traced back to the user ID
+func newPseudonym() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "erased-" + hex.EncodeToString(buf), nil
+}
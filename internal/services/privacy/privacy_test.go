@@ -0,0 +1,110 @@
+package privacy
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDB connects to the database in TEST_DATABASE_DSN, e.g.
+// "host=localhost user=postgres password=postgres dbname=project_core_test",
+// and skips the test when it is not set
+func testDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN not set")
+	}
+	database, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := database.AutoMigrate(&db.BaseProject{}, &db.ProjectMember{}, &db.ProjectType{}, &db.CustomFieldDefinition{}, &db.Company{}, &db.CompanyMember{}, &db.Team{}, &db.TeamMember{}, &db.ProjectTeam{}, &db.Tag{}, &db.ProjectTag{}, &db.ProjectTemplate{}, &db.ProjectTemplateMember{}, &db.CompanyRole{}, &db.CompensationRate{}, &db.ErasureRecord{}, &db.CalendarToken{}, &db.Notification{}, &db.NotificationPreference{}, &db.EmailSettings{}, &db.EmailMessage{}, &db.ChangeEvent{}, &db.ProjectPresence{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return database
+}
+
+// inRollback runs fn in a transaction that is always rolled back, so tests
+// leave the database as they found it
+func inRollback(t *testing.T, database *gorm.DB, fn func(tx *gorm.DB)) {
+	t.Helper()
+
+	rollback := errors.New("rollback")
+	err := database.Transaction(func(tx *gorm.DB) error {
+		fn(tx)
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatal(err)
+	}
+}
+
+func TestEraseKeepsCompanyProjectsAndTemplates(t *testing.T) {
+	database := testDB(t)
+
+	inRollback(t, database, func(tx *gorm.DB) {
+		const subject, pseudonym = "erasure-subject", "erased-test"
+		live, gone := "erasure-live-company", "erasure-deleted-company"
+
+		// The deleted company's projects and templates outlive its row
+		seed := []interface{}{
+			&db.Company{ID: live, Name: "Live", OwnerID: "erasure-owner"},
+			&db.BaseProject{Title: "Live project", Status: "active", OwnerID: subject, CompanyID: &live},
+			&db.BaseProject{Title: "Orphaned project", Status: "active", OwnerID: subject, CompanyID: &gone},
+			&db.BaseProject{Title: "Personal project", Status: "active", OwnerID: subject},
+			&db.ProjectTemplate{Name: "live", Title: "Live template", OwnerID: subject, CompanyID: &live},
+			&db.ProjectTemplate{Name: "orphaned", Title: "Orphaned template", OwnerID: subject, CompanyID: &gone},
+			&db.ProjectTemplate{Name: "personal", Title: "Personal template", OwnerID: subject},
+		}
+		for _, row := range seed {
+			if err := tx.Create(row).Error; err != nil {
+				t.Fatalf("seed: %v", err)
+			}
+		}
+
+		if _, err := erase(tx, subject, pseudonym, ErasurePolicy{PersonalProjects: PersonalProjectsDelete}); err != nil {
+			t.Fatalf("erase: %v", err)
+		}
+
+		owners := func(model interface{}, companyID *string) []string {
+			t.Helper()
+			query := tx.Model(model).Where("owner_id IN ?", []string{subject, pseudonym, "erasure-owner"})
+			if companyID == nil {
+				query = query.Where("company_id IS NULL")
+			} else {
+				query = query.Where("company_id = ?", *companyID)
+			}
+			var ownerIDs []string
+			if err := query.Pluck("owner_id", &ownerIDs).Error; err != nil {
+				t.Fatal(err)
+			}
+			return ownerIDs
+		}
+		tests := []struct {
+			name      string
+			model     interface{}
+			companyID *string
+			want      []string
+		}{
+			{"live company project", &db.BaseProject{}, &live, []string{"erasure-owner"}},
+			{"deleted company project", &db.BaseProject{}, &gone, []string{pseudonym}},
+			{"personal project", &db.BaseProject{}, nil, nil},
+			{"live company template", &db.ProjectTemplate{}, &live, []string{"erasure-owner"}},
+			{"deleted company template", &db.ProjectTemplate{}, &gone, []string{pseudonym}},
+			{"personal template", &db.ProjectTemplate{}, nil, nil},
+		}
+		for _, tt := range tests {
+			got := owners(tt.model, tt.companyID)
+			if len(got) != len(tt.want) || (len(got) == 1 && got[0] != tt.want[0]) {
+				t.Errorf("%s: owners = %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,363 @@
+package projects
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+	"gorm.io/gorm"
+)
+
+// MaxBulkItems caps the number of projects a single bulk request may touch
+const MaxBulkItems = 500
+
+type BulkItemResult struct {
+	ProjectID uint
+	Success   bool
+	Error     string
+}
+
+type BulkResult struct {
+	Results    []BulkItemResult
+	Succeeded  int
+	Failed     int
+	Atomic     bool
+	RolledBack bool // Atomic batch where nothing was applied
+}
+
+// bulkOperation validates every project up front, then applies the change.
+// In atomic mode all changes run in one transaction and any failure aborts
+// the whole batch; otherwise each project is applied on its own.
+type bulkOperation struct {
+	validate func(project *db.BaseProject) error
+	apply    func(tx *gorm.DB, project *db.BaseProject) error
+	applied  func(project *db.BaseProject) // Optional, called after each committed change
+}
+
+var validProjectStatuses = map[string]bool{
+	"active":    true,
+	"completed": true,
+	"paused":    true,
+	"cancelled": true,
+}
+
+func (s *ProjectService) BulkUpdateStatus(projectIDs []uint, status string, atomic bool, userID string) (*BulkResult, error) {
+	if !validProjectStatuses[status] {
+		return nil, errors.New("invalid project status")
+	}
+
+	oldStatuses := make(map[uint]string)
+	return s.runBulk(projectIDs, atomic, bulkOperation{
+		validate: func(project *db.BaseProject) error {
+			canUpdate, err := s.userCanUpdateProject(userID, project)
+			if err != nil {
+				return err
+			}
+			if !canUpdate {
+				return errors.New("user cannot update this project")
+			}
+			oldStatuses[project.ID] = project.Status
+			return nil
+		},
+		apply: func(tx *gorm.DB, project *db.BaseProject) error {
+			return tx.Model(project).Updates(map[string]interface{}{
+				"status":       status,
+				"completed_at": completedAt(project.Status, status, project.CompletedAt),
+				"updated_at":   time.Now(),
+			}).Error
+		},
+		applied: func(project *db.BaseProject) {
+			project.Status = status
+			s.recordEvent(events.ProjectEvent(events.ActionUpdated, project, userID))
+			if oldStatuses[project.ID] != status {
+				s.notifyProjectStatusChanged(project, oldStatuses[project.ID], userID)
+			}
+		},
+	})
+}
+
+func (s *ProjectService) BulkReassignOwner(projectIDs []uint, newOwnerID string, atomic bool, userID string) (*BulkResult, error) {
+	if newOwnerID == "" {
+		return nil, errors.New("new owner ID is required")
+	}
+
+	return s.runBulk(projectIDs, atomic, bulkOperation{
+		validate: func(project *db.BaseProject) error {
+			// Business rule: only the owner can hand a project over
+			if project.OwnerID != userID {
+				return errors.New("only project owner can reassign project")
+			}
+			if project.CompanyID != nil {
+				var member db.CompanyMember
+				err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", *project.CompanyID, newOwnerID, "active")
+				if err != nil {
+					return errors.New("new owner is not an active member of the project's company")
+				}
+			}
+			return nil
+		},
+		apply: func(tx *gorm.DB, project *db.BaseProject) error {
+			return tx.Model(project).Updates(map[string]interface{}{"owner_id": newOwnerID, "updated_at": time.Now()}).Error
+		},
+		applied: func(project *db.BaseProject) {
+			project.OwnerID = newOwnerID
+			s.recordEvent(events.ProjectEvent(events.ActionOwnerChanged, project, userID))
+		},
+	})
+}
+
+// BulkMoveToCompany moves projects to another company, or makes them personal when companyID is nil.
+func (s *ProjectService) BulkMoveToCompany(projectIDs []uint, companyID *string, atomic bool, userID string) (*BulkResult, error) {
+	if companyID != nil {
+		canCreate, err := s.userCanCreateInCompany(userID, *companyID)
+		if err != nil {
+			return nil, err
+		}
+		if !canCreate {
+			return nil, errors.New("user cannot create projects in this company")
+		}
+	}
+
+	// Custom field values checked against the new company, by project
+	customFields := make(map[uint]map[string]interface{})
+	return s.runBulk(projectIDs, atomic, bulkOperation{
+		validate: func(project *db.BaseProject) error {
+			if project.OwnerID != userID {
+				return errors.New("only project owner can move project")
+			}
+			// Business rule: a project tree always lives in a single company
+			if project.ParentID != nil {
+				return errors.New("sub-projects cannot change company")
+			}
+			var childCount int64
+			if err := s.projectRepo.Count(&childCount, "parent_id = ?", project.ID); err != nil {
+				return err
+			}
+			if childCount > 0 {
+				return errors.New("project has sub-projects")
+			}
+
+			// Values of custom fields the new company does not define are
+			// dropped, and the fields it requires must already be set
+			moved := *project
+			moved.CompanyID = companyID
+			if err := s.applyCustomFields(&moved, nil); err != nil {
+				return err
+			}
+			customFields[project.ID] = moved.CustomFields
+			return nil
+		},
+		apply: func(tx *gorm.DB, project *db.BaseProject) error {
+			// Team grants are company-scoped and do not follow the project
+			if err := tx.Where("project_id = ?", project.ID).Delete(&db.ProjectTeam{}).Error; err != nil {
+				return err
+			}
+			// So are company tags; personal tags stay
+			if err := tx.Where("project_id = ? AND tag_id IN (?)", project.ID,
+				tx.Model(&db.Tag{}).Select("id").Where("company_id IS NOT NULL")).Delete(&db.ProjectTag{}).Error; err != nil {
+				return err
+			}
+			project.CustomFields = customFields[project.ID]
+			return tx.Model(project).Select("company_id", "custom_fields", "updated_at").
+				Updates(&db.BaseProject{CompanyID: companyID, CustomFields: project.CustomFields, UpdatedAt: time.Now()}).Error
+		},
+		applied: func(project *db.BaseProject) {
+			project.CompanyID = companyID
+			s.recordEvent(events.ProjectEvent(events.ActionMoved, project, userID))
+		},
+	})
+}
+
+func (s *ProjectService) BulkDelete(projectIDs []uint, atomic bool, userID string) (*BulkResult, error) {
+	return s.runBulk(projectIDs, atomic, bulkOperation{
+		validate: func(project *db.BaseProject) error {
+			return s.checkCanDelete(project, userID)
+		},
+		apply: deleteProject,
+		applied: func(project *db.BaseProject) {
+			s.recordEvent(events.ProjectEvent(events.ActionDeleted, project, userID))
+		},
+	})
+}
+
+func (s *ProjectService) BulkAddMember(projectIDs []uint, memberUserID, role string, permissions []string, atomic bool, requestingUserID string) (*BulkResult, error) {
+	return s.runBulk(projectIDs, atomic, bulkOperation{
+		validate: func(project *db.BaseProject) error {
+			canManage, err := s.userCanManageProjectMembers(requestingUserID, project)
+			if err != nil {
+				return err
+			}
+			if !canManage {
+				return errors.New("user cannot add members to this project")
+			}
+			var existing db.ProjectMember
+			if err := s.memberRepo.FindOne(&existing, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(project.ID)), "core", memberUserID); err == nil {
+				return errors.New("user is already a member of this project")
+			}
+			return nil
+		},
+		apply: func(tx *gorm.DB, project *db.BaseProject) error {
+			member := &db.ProjectMember{
+				ProjectID:   strconv.Itoa(int(project.ID)),
+				ProjectType: "core",
+				UserID:      memberUserID,
+				Role:        role,
+				Permissions: permissions,
+				JoinedAt:    time.Now(),
+			}
+			return tx.Create(member).Error
+		},
+		applied: func(project *db.BaseProject) {
+			member := &db.ProjectMember{
+				ProjectID:   strconv.Itoa(int(project.ID)),
+				ProjectType: "core",
+				UserID:      memberUserID,
+				Role:        role,
+				Permissions: permissions,
+			}
+			s.recordEvent(events.ProjectMemberEvent(events.ActionMemberAdded, project, member, requestingUserID))
+			s.notify([]string{memberUserID}, notifications.ProjectMemberAdded(project, role))
+		},
+	})
+}
+
+func (s *ProjectService) BulkRemoveMember(projectIDs []uint, memberUserID string, atomic bool, requestingUserID string) (*BulkResult, error) {
+	return s.runBulk(projectIDs, atomic, bulkOperation{
+		validate: func(project *db.BaseProject) error {
+			// Users can remove themselves
+			if memberUserID != requestingUserID {
+				canManage, err := s.userCanManageProjectMembers(requestingUserID, project)
+				if err != nil {
+					return err
+				}
+				if !canManage {
+					return errors.New("user cannot remove members from this project")
+				}
+			}
+			var existing db.ProjectMember
+			if err := s.memberRepo.FindOne(&existing, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(project.ID)), "core", memberUserID); err != nil {
+				return errors.New("user is not a member of this project")
+			}
+			return nil
+		},
+		apply: func(tx *gorm.DB, project *db.BaseProject) error {
+			return tx.Where("project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(project.ID)), "core", memberUserID).Delete(&db.ProjectMember{}).Error
+		},
+		applied: func(project *db.BaseProject) {
+			member := &db.ProjectMember{ProjectID: strconv.Itoa(int(project.ID)), ProjectType: "core", UserID: memberUserID}
+			s.recordEvent(events.ProjectMemberEvent(events.ActionMemberRemoved, project, member, requestingUserID))
+		},
+	})
+}
+
+func (s *ProjectService) runBulk(projectIDs []uint, atomic bool, op bulkOperation) (*BulkResult, error) {
+	projectIDs = uniqueIDs(projectIDs)
+	if len(projectIDs) == 0 {
+		return nil, errors.New("at least one project ID is required")
+	}
+	if len(projectIDs) > MaxBulkItems {
+		return nil, errors.New("bulk request exceeds maximum of " + strconv.Itoa(MaxBulkItems) + " projects")
+	}
+
+	var found []db.BaseProject
+	if err := s.projectRepo.FindWhere(&found, "id IN ?", projectIDs); err != nil {
+		return nil, err
+	}
+	projectsByID := make(map[uint]*db.BaseProject, len(found))
+	for i := range found {
+		projectsByID[found[i].ID] = &found[i]
+	}
+
+	result := &BulkResult{Results: make([]BulkItemResult, len(projectIDs)), Atomic: atomic}
+
+	// Validate every item before changing anything
+	valid := make([]int, 0, len(projectIDs))
+	for i, id := range projectIDs {
+		result.Results[i].ProjectID = id
+		project, ok := projectsByID[id]
+		if !ok {
+			result.Results[i].Error = "project not found"
+			continue
+		}
+		if err := op.validate(project); err != nil {
+			result.Results[i].Error = err.Error()
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	if atomic {
+		applied := false
+		if len(valid) == len(projectIDs) {
+			failedIndex := -1
+			err := s.database.WithTransaction(func(tx *gorm.DB) error {
+				for _, i := range valid {
+					if err := op.apply(tx, projectsByID[projectIDs[i]]); err != nil {
+						failedIndex = i
+						return err
+					}
+				}
+				return nil
+			})
+			switch {
+			case err == nil:
+				applied = true
+				for _, i := range valid {
+					result.Results[i].Success = true
+				}
+			case failedIndex >= 0:
+				result.Results[failedIndex].Error = err.Error()
+			default:
+				return nil, err
+			}
+		}
+
+		if !applied {
+			result.RolledBack = true
+			for i := range result.Results {
+				if result.Results[i].Error == "" {
+					result.Results[i].Error = "not applied: batch was rolled back"
+				}
+			}
+		}
+	} else {
+		for _, i := range valid {
+			err := s.database.WithTransaction(func(tx *gorm.DB) error {
+				return op.apply(tx, projectsByID[projectIDs[i]])
+			})
+			if err != nil {
+				result.Results[i].Error = err.Error()
+				continue
+			}
+			result.Results[i].Success = true
+		}
+	}
+
+	for _, item := range result.Results {
+		if item.Success {
+			result.Succeeded++
+			if op.applied != nil {
+				op.applied(projectsByID[item.ProjectID])
+			}
+		} else {
+			result.Failed++
+		}
+	}
+
+	return result, nil
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
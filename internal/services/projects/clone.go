@@ -0,0 +1,96 @@
+package projects
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+)
+
+type CloneOptions struct {
+	Title          string     // Defaults to the source title
+	StartDate      *time.Time // Shifts both dates, keeping the source duration
+	IncludeMembers bool
+}
+
+// CloneProject copies a project, and optionally its members, into a new project owned by userID.
+func (s *ProjectService) CloneProject(id uint, options CloneOptions, userID string) (*db.BaseProject, []db.ProjectMember, error) {
+	source, err := s.GetProject(id, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clone := &db.BaseProject{
+		Title:        source.Title,
+		Description:  source.Description,
+		Status:       "active",
+		OwnerID:      userID,
+		CompanyID:    source.CompanyID,
+		ParentID:     source.ParentID,
+		StartDate:    source.StartDate,
+		EndDate:      source.EndDate,
+		ProjectType:  source.ProjectType,
+		CustomFields: source.CustomFields,
+	}
+	if options.Title != "" {
+		clone.Title = options.Title
+	}
+	if options.StartDate != nil {
+		clone.StartDate, clone.EndDate = ShiftDates(source.StartDate, source.EndDate, *options.StartDate)
+	}
+
+	if _, err := s.CreateProject(clone); err != nil {
+		return nil, nil, err
+	}
+
+	if !options.IncludeMembers {
+		return clone, nil, nil
+	}
+
+	var sourceMembers []db.ProjectMember
+	if err := s.memberRepo.FindWhere(&sourceMembers, "project_id = ? AND project_type = ?", strconv.Itoa(int(source.ID)), "core"); err != nil {
+		s.rollbackClone(clone)
+		return nil, nil, err
+	}
+
+	members := make([]db.ProjectMember, 0, len(sourceMembers))
+	now := time.Now()
+	for _, sourceMember := range sourceMembers {
+		member := db.ProjectMember{
+			ProjectID:   strconv.Itoa(int(clone.ID)),
+			ProjectType: "core",
+			UserID:      sourceMember.UserID,
+			Role:        sourceMember.Role,
+			Permissions: sourceMember.Permissions,
+			JoinedAt:    now,
+		}
+		if err := s.memberRepo.Create(&member); err != nil {
+			// Rollback the clone if a member copy fails
+			s.rollbackClone(clone)
+			return nil, nil, errors.New("failed to copy project members: " + err.Error())
+		}
+		members = append(members, member)
+	}
+
+	return clone, members, nil
+}
+
+func (s *ProjectService) rollbackClone(clone *db.BaseProject) {
+	s.memberRepo.DeleteWhere("project_id = ? AND project_type = ?", strconv.Itoa(int(clone.ID)), "core")
+	s.projectRepo.Delete(clone)
+}
+
+// ShiftDates moves a start/end pair so that it begins at newStart while keeping
+// its duration. A missing start date leaves the end date unchanged.
+func ShiftDates(start, end *time.Time, newStart time.Time) (*time.Time, *time.Time) {
+	if start == nil {
+		return &newStart, end
+	}
+	if end == nil {
+		return &newStart, nil
+	}
+
+	newEnd := newStart.Add(end.Sub(*start))
+	return &newStart, &newEnd
+}
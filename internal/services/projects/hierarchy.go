@@ -0,0 +1,205 @@
+package projects
+
+import (
+	"errors"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+)
+
+// ProjectNode is a project together with its sub-projects. Rollup summarizes
+// the whole subtree rooted at Project, including the project itself.
+type ProjectNode struct {
+	Project  db.BaseProject
+	Children []*ProjectNode
+	Rollup   ProjectRollup
+}
+
+type ProjectRollup struct {
+	Status       string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	ProjectCount int
+}
+
+func (s *ProjectService) GetProjectChildren(id uint, userID string) ([]db.BaseProject, error) {
+	if _, err := s.GetProject(id, userID); err != nil {
+		return nil, err
+	}
+
+	var children []db.BaseProject
+	if err := s.projectRepo.FindWhere(&children, "parent_id = ?", id); err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+func (s *ProjectService) GetProjectTree(id uint, userID string) (*ProjectNode, error) {
+	project, err := s.GetProject(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &ProjectNode{Project: *project}
+
+	// Load the subtree level by level
+	level := []*ProjectNode{root}
+	for len(level) > 0 {
+		parentIDs := make([]uint, len(level))
+		byID := make(map[uint]*ProjectNode, len(level))
+		for i, node := range level {
+			parentIDs[i] = node.Project.ID
+			byID[node.Project.ID] = node
+		}
+
+		var children []db.BaseProject
+		if err := s.projectRepo.FindWhere(&children, "parent_id IN ?", parentIDs); err != nil {
+			return nil, err
+		}
+
+		var next []*ProjectNode
+		for _, child := range children {
+			node := &ProjectNode{Project: child}
+			parent := byID[*child.ParentID]
+			parent.Children = append(parent.Children, node)
+			next = append(next, node)
+		}
+		level = next
+	}
+
+	rollupTree(root)
+	return root, nil
+}
+
+// MoveProject attaches a project under a new parent, or detaches it when parentID is nil.
+func (s *ProjectService) MoveProject(id uint, parentID *uint, userID string) (*db.BaseProject, error) {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(id, &project); err != nil {
+		return nil, err
+	}
+
+	canUpdate, err := s.userCanUpdateProject(userID, &project)
+	if err != nil {
+		return nil, err
+	}
+	if !canUpdate {
+		return nil, errors.New("user cannot update this project")
+	}
+
+	if parentID != nil {
+		var parent db.BaseProject
+		if err := s.projectRepo.FindByID(*parentID, &parent); err != nil {
+			return nil, errors.New("parent project not found")
+		}
+		if err := s.validateParent(&project, &parent, userID); err != nil {
+			return nil, err
+		}
+
+		// Business rule: a project cannot be moved below itself or one of its descendants
+		for ancestor := &parent; ancestor != nil; {
+			if ancestor.ID == project.ID {
+				return nil, errors.New("project hierarchy cannot contain cycles")
+			}
+			ancestor, err = s.getParentProject(ancestor)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	project.ParentID = parentID
+	project.UpdatedAt = time.Now()
+
+	if err := s.projectRepo.Update(&project); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(events.ProjectEvent(events.ActionMoved, &project, userID))
+
+	return &project, nil
+}
+
+// validateParent checks that the user may attach sub-projects to parent and
+// that project lives in the same company as the parent.
+func (s *ProjectService) validateParent(project, parent *db.BaseProject, userID string) error {
+	canUpdate, err := s.userCanUpdateProject(userID, parent)
+	if err != nil {
+		return err
+	}
+	if !canUpdate {
+		return errors.New("user cannot add sub-projects to this project")
+	}
+
+	if !sameCompany(project.CompanyID, parent.CompanyID) {
+		return errors.New("sub-project must belong to the parent's company")
+	}
+
+	return nil
+}
+
+func (s *ProjectService) getParentProject(project *db.BaseProject) (*db.BaseProject, error) {
+	if project.ParentID == nil {
+		return nil, nil
+	}
+
+	var parent db.BaseProject
+	if err := s.projectRepo.FindByID(*project.ParentID, &parent); err != nil {
+		return nil, err
+	}
+	return &parent, nil
+}
+
+// rollupTree fills in Rollup for every node, children first.
+func rollupTree(node *ProjectNode) {
+	rollup := ProjectRollup{
+		StartDate:    node.Project.StartDate,
+		EndDate:      node.Project.EndDate,
+		ProjectCount: 1,
+	}
+	statuses := []string{node.Project.Status}
+
+	for _, child := range node.Children {
+		rollupTree(child)
+		rollup.ProjectCount += child.Rollup.ProjectCount
+		statuses = append(statuses, child.Rollup.Status)
+
+		if child.Rollup.StartDate != nil && (rollup.StartDate == nil || child.Rollup.StartDate.Before(*rollup.StartDate)) {
+			rollup.StartDate = child.Rollup.StartDate
+		}
+		if child.Rollup.EndDate != nil && (rollup.EndDate == nil || child.Rollup.EndDate.After(*rollup.EndDate)) {
+			rollup.EndDate = child.Rollup.EndDate
+		}
+	}
+
+	rollup.Status = rollupStatus(statuses)
+	node.Rollup = rollup
+}
+
+// rollupStatus derives a subtree status: any active work keeps it active, then
+// paused work, and it is only cancelled when everything in it was cancelled.
+func rollupStatus(statuses []string) string {
+	counts := make(map[string]int)
+	for _, status := range statuses {
+		counts[status]++
+	}
+
+	switch {
+	case counts["active"] > 0:
+		return "active"
+	case counts["paused"] > 0:
+		return "paused"
+	case counts["cancelled"] == len(statuses):
+		return "cancelled"
+	default:
+		return "completed"
+	}
+}
+
+func sameCompany(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
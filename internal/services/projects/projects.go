@@ -4,10 +4,16 @@ import (
 	"errors"
 	"log/slog"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/customfields"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
 	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
 )
 
 /* ------------------------------------------------------------------ */
@@ -24,24 +30,56 @@ var log = slog.Default().With(
 /* ------------------------------------------------------------------ */
 
 type ProjectService struct {
-	projectRepo       *pgconnect.Repository[db.BaseProject]
-	memberRepo        *pgconnect.Repository[db.ProjectMember]
-	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+	database            *pgconnect.DB
+	notificationService *notifications.NotificationService
+	eventService        *events.EventService
+	projectRepo         *pgconnect.Repository[db.BaseProject]
+	memberRepo          *pgconnect.Repository[db.ProjectMember]
+	companyMemberRepo   *pgconnect.Repository[db.CompanyMember]
+	teamRepo            *pgconnect.Repository[db.Team]
+	projectTeamRepo     *pgconnect.Repository[db.ProjectTeam]
+	projectTypeRepo     *pgconnect.Repository[db.ProjectType]
+	definitionRepo      *pgconnect.Repository[db.CustomFieldDefinition]
 }
 
-func NewProjectService(database *pgconnect.DB) *ProjectService {
+// ProjectFilter narrows a project listing. The zero value matches every project.
+type ProjectFilter struct {
+	CustomFields map[string]string // Custom field key to value; multiselect fields match any selected option
+	Tags         []string          // Tag names, ignoring case; a project must have all of them
+}
+
+func NewProjectService(database *pgconnect.DB, notificationService *notifications.NotificationService, eventService *events.EventService) *ProjectService {
 	return &ProjectService{
-		projectRepo:       pgconnect.NewRepository[db.BaseProject](database),
-		memberRepo:        pgconnect.NewRepository[db.ProjectMember](database),
-		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+		database:            database,
+		notificationService: notificationService,
+		eventService:        eventService,
+		projectRepo:         pgconnect.NewRepository[db.BaseProject](database),
+		memberRepo:          pgconnect.NewRepository[db.ProjectMember](database),
+		companyMemberRepo:   pgconnect.NewRepository[db.CompanyMember](database),
+		teamRepo:            pgconnect.NewRepository[db.Team](database),
+		projectTeamRepo:     pgconnect.NewRepository[db.ProjectTeam](database),
+		projectTypeRepo:     pgconnect.NewRepository[db.ProjectType](database),
+		definitionRepo:      pgconnect.NewRepository[db.CustomFieldDefinition](database),
 	}
 }
 
 func (s *ProjectService) CreateProject(project *db.BaseProject) (*db.BaseProject, error) {
 	log.Info("create-core-project:start", "userID", project.OwnerID)
 
-	// Business logic: validate company ownership if company is specified
-	if project.CompanyID != nil {
+	// Sub-projects inherit the parent's company and are authorized through the parent
+	if project.ParentID != nil {
+		parent, err := s.getParentProject(project)
+		if err != nil {
+			return nil, errors.New("parent project not found")
+		}
+		if project.CompanyID == nil {
+			project.CompanyID = parent.CompanyID
+		}
+		if err := s.validateParent(project, parent, project.OwnerID); err != nil {
+			return nil, err
+		}
+	} else if project.CompanyID != nil {
+		// Business logic: validate company ownership if company is specified
 		canCreate, err := s.userCanCreateInCompany(project.OwnerID, *project.CompanyID)
 		if err != nil {
 			return nil, err
@@ -51,10 +89,26 @@ func (s *ProjectService) CreateProject(project *db.BaseProject) (*db.BaseProject
 		}
 	}
 
+	// Extended projects must use a registered type
+	if project.ProjectType != nil {
+		var projectType db.ProjectType
+		if err := s.projectTypeRepo.FindByID(*project.ProjectType, &projectType); err != nil {
+			return nil, errors.New("project type not found")
+		}
+	}
+
+	// Custom fields are validated against the company and project type definitions
+	input := project.CustomFields
+	project.CustomFields = nil
+	if err := s.applyCustomFields(project, input); err != nil {
+		return nil, err
+	}
+
 	// Set defaults
 	if project.Status == "" {
 		project.Status = "active"
 	}
+	project.CompletedAt = completedAt("", project.Status, nil)
 	now := time.Now()
 	project.CreatedAt = now
 	project.UpdatedAt = now
@@ -64,6 +118,7 @@ func (s *ProjectService) CreateProject(project *db.BaseProject) (*db.BaseProject
 		return nil, err
 	}
 	log.Info("project-created", "ID", project.ID)
+	s.recordEvent(events.ProjectEvent(events.ActionCreated, project, project.OwnerID))
 
 	return project, nil
 }
@@ -110,7 +165,9 @@ func (s *ProjectService) UpdateProject(id uint, updates *db.BaseProject, userID
 	if updates.Description != nil {
 		project.Description = updates.Description
 	}
+	oldStatus := project.Status
 	if updates.Status != "" {
+		project.CompletedAt = completedAt(project.Status, updates.Status, project.CompletedAt)
 		project.Status = updates.Status
 	}
 	if updates.StartDate != nil {
@@ -119,12 +176,22 @@ func (s *ProjectService) UpdateProject(id uint, updates *db.BaseProject, userID
 	if updates.EndDate != nil {
 		project.EndDate = updates.EndDate
 	}
+	if updates.CustomFields != nil {
+		if err := s.applyCustomFields(&project, updates.CustomFields); err != nil {
+			return nil, err
+		}
+	}
 	project.UpdatedAt = time.Now()
 
 	if err := s.projectRepo.Update(&project); err != nil {
 		return nil, err
 	}
 
+	s.recordEvent(events.ProjectEvent(events.ActionUpdated, &project, userID))
+	if project.Status != oldStatus {
+		s.notifyProjectStatusChanged(&project, oldStatus, userID)
+	}
+
 	return &project, nil
 }
 
@@ -134,15 +201,22 @@ func (s *ProjectService) DeleteProject(id uint, userID string) error {
 		return err
 	}
 
-	// Business logic: only owner can delete
-	if project.OwnerID != userID {
-		return errors.New("only project owner can delete project")
+	if err := s.checkCanDelete(&project, userID); err != nil {
+		return err
 	}
 
-	return s.projectRepo.Delete(&project)
+	if err := s.database.WithTransaction(func(tx *gorm.DB) error {
+		return deleteProject(tx, &project)
+	}); err != nil {
+		return err
+	}
+
+	s.recordEvent(events.ProjectEvent(events.ActionDeleted, &project, userID))
+
+	return nil
 }
 
-func (s *ProjectService) GetUserProjects(userID string) ([]db.BaseProject, error) {
+func (s *ProjectService) GetUserProjects(userID string, filter ProjectFilter) ([]db.BaseProject, error) {
 	// Get projects where user is owner
 	var ownedProjects []db.BaseProject
 	if err := s.projectRepo.FindWhere(&ownedProjects, "owner_id = ?", userID); err != nil {
@@ -151,7 +225,7 @@ func (s *ProjectService) GetUserProjects(userID string) ([]db.BaseProject, error
 
 	// Get projects where user is a member
 	var members []db.ProjectMember
-	if err := s.memberRepo.FindWhere(&members, "user_id = ?", userID); err != nil {
+	if err := s.memberRepo.FindWhere(&members, "user_id = ? AND project_type = ?", userID, "core"); err != nil {
 		return nil, err
 	}
 
@@ -169,9 +243,20 @@ func (s *ProjectService) GetUserProjects(userID string) ([]db.BaseProject, error
 		}
 	}
 
+	// Get projects granted to the user's teams
+	teamProjects, err := s.getTeamProjects(userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Combine and deduplicate
 	allProjects := append(ownedProjects, memberProjects...)
-	return s.deduplicateProjects(allProjects), nil
+	allProjects = append(allProjects, teamProjects...)
+	projects := filterProjects(s.deduplicateProjects(allProjects), filter)
+	if len(filter.Tags) > 0 {
+		return s.filterProjectsByTags(projects, filter.Tags, userID)
+	}
+	return projects, nil
 }
 
 func (s *ProjectService) AddProjectMember(projectID uint, userID, role string, permissions []string, requestingUserID string) (*db.ProjectMember, error) {
@@ -191,7 +276,7 @@ func (s *ProjectService) AddProjectMember(projectID uint, userID, role string, p
 
 	// Check if user is already a member
 	var existing db.ProjectMember
-	err = s.memberRepo.FindOne(&existing, "project_id = ? AND user_id = ?", strconv.Itoa(int(projectID)), userID)
+	err = s.memberRepo.FindOne(&existing, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(projectID)), "core", userID)
 	if err == nil {
 		// User already exists as member
 		return nil, errors.New("user is already a member of this project")
@@ -210,9 +295,57 @@ func (s *ProjectService) AddProjectMember(projectID uint, userID, role string, p
 		return nil, err
 	}
 
+	s.recordEvent(events.ProjectMemberEvent(events.ActionMemberAdded, &project, member, requestingUserID))
+	s.notify([]string{userID}, notifications.ProjectMemberAdded(&project, role))
+
 	return member, nil
 }
 
+// UpdateProjectMember changes a member's role and permissions. nil
+// permissions keep the current ones.
+func (s *ProjectService) UpdateProjectMember(projectID uint, userID, role string, permissions []string, requestingUserID string) (*db.ProjectMember, error) {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
+		return nil, err
+	}
+
+	canManage, err := s.userCanManageProjectMembers(requestingUserID, &project)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("user cannot update members of this project")
+	}
+
+	var member db.ProjectMember
+	if err := s.memberRepo.FindOne(&member, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(projectID)), "core", userID); err != nil {
+		return nil, errors.New("user is not a member of this project")
+	}
+
+	oldRole := member.Role
+	if role != "" {
+		member.Role = role
+	}
+	if permissions != nil {
+		member.Permissions = permissions
+	}
+
+	// ProjectMember has no primary key, so the row is matched explicitly
+	err = s.database.Model(&db.ProjectMember{}).
+		Where("project_id = ? AND project_type = ? AND user_id = ?", member.ProjectID, "core", userID).
+		Updates(map[string]interface{}{"role": member.Role, "permissions": member.Permissions}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(events.ProjectMemberEvent(events.ActionMemberUpdated, &project, &member, requestingUserID))
+	if member.Role != oldRole {
+		s.notify([]string{userID}, notifications.ProjectRoleChanged(&project, member.Role))
+	}
+
+	return &member, nil
+}
+
 func (s *ProjectService) GetProjectMembers(projectID uint, requestingUserID string) ([]db.ProjectMember, error) {
 	var project db.BaseProject
 	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
@@ -229,24 +362,156 @@ func (s *ProjectService) GetProjectMembers(projectID uint, requestingUserID stri
 	}
 
 	var members []db.ProjectMember
-	if err := s.memberRepo.FindWhere(&members, "project_id = ?", strconv.Itoa(int(projectID))); err != nil {
+	if err := s.memberRepo.FindWhere(&members, "project_id = ? AND project_type = ?", strconv.Itoa(int(projectID)), "core"); err != nil {
 		return nil, err
 	}
 
 	return members, nil
 }
 
-// Private helper methods for business logic
+func (s *ProjectService) AddProjectTeam(projectID, teamID uint, role string, permissions []string, requestingUserID string) (*db.ProjectTeam, error) {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
+		return nil, err
+	}
 
-func (s *ProjectService) userCanCreateInCompany(userID, companyID string) (bool, error) {
+	canAddMembers, err := s.userCanManageProjectMembers(requestingUserID, &project)
+	if err != nil {
+		return nil, err
+	}
+	if !canAddMembers {
+		return nil, errors.New("user cannot add members to this project")
+	}
+
+	var team db.Team
+	if err := s.teamRepo.FindByID(teamID, &team); err != nil {
+		return nil, err
+	}
+
+	// Business rule: teams only get access to projects of their own company
+	if project.CompanyID == nil || *project.CompanyID != team.CompanyID {
+		return nil, errors.New("team and project belong to different companies")
+	}
+
+	var existing db.ProjectTeam
+	err = s.projectTeamRepo.FindOne(&existing, "project_id = ? AND team_id = ?", projectID, teamID)
+	if err == nil {
+		return nil, errors.New("team already has access to this project")
+	}
+
+	grant := &db.ProjectTeam{
+		ProjectID:   projectID,
+		TeamID:      teamID,
+		Role:        role,
+		Permissions: permissions,
+		GrantedBy:   requestingUserID,
+		GrantedAt:   time.Now(),
+	}
+
+	if err := s.projectTeamRepo.Create(grant); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(events.ProjectTeamEvent(events.ActionTeamAdded, &project, grant, requestingUserID))
+
+	return grant, nil
+}
+
+func (s *ProjectService) RemoveProjectTeam(projectID, teamID uint, requestingUserID string) error {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
+		return err
+	}
+
+	canManage, err := s.userCanManageProjectMembers(requestingUserID, &project)
+	if err != nil {
+		return err
+	}
+	if !canManage {
+		return errors.New("user cannot remove members from this project")
+	}
+
+	if err := s.projectTeamRepo.DeleteWhere("project_id = ? AND team_id = ?", projectID, teamID); err != nil {
+		return err
+	}
+
+	s.recordEvent(events.ProjectTeamEvent(events.ActionTeamRemoved, &project, &db.ProjectTeam{ProjectID: projectID, TeamID: teamID}, requestingUserID))
+
+	return nil
+}
+
+func (s *ProjectService) GetProjectTeams(projectID uint, requestingUserID string) ([]db.ProjectTeam, error) {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
+		return nil, err
+	}
+
+	canAccess, err := s.userCanAccessProject(requestingUserID, &project)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return nil, errors.New("user cannot access this project")
+	}
+
+	var grants []db.ProjectTeam
+	if err := s.projectTeamRepo.FindWhere(&grants, "project_id = ?", projectID); err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// CanAccessProject reports whether the user can see the project, for
+// filtering the event stream. Deleted projects are not accessible.
+func (s *ProjectService) CanAccessProject(userID string, projectID uint) (bool, error) {
+	return s.checkProject(userID, projectID, s.userCanAccessProject)
+}
+
+// CanUpdateProject reports whether the user can change the project
+func (s *ProjectService) CanUpdateProject(userID string, projectID uint) (bool, error) {
+	return s.checkProject(userID, projectID, s.userCanUpdateProject)
+}
+
+// CanManageProjectMembers reports whether the user can add, update and remove
+// the project's members and teams
+func (s *ProjectService) CanManageProjectMembers(userID string, projectID uint) (bool, error) {
+	return s.checkProject(userID, projectID, s.userCanManageProjectMembers)
+}
+
+// CanAccessCompany reports whether the user is an active member of the company
+func (s *ProjectService) CanAccessCompany(userID, companyID string) (bool, error) {
 	var member db.CompanyMember
 	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
-	if err != nil {
-		return false, nil // User not found in company
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
 	}
+	return err == nil, err
+}
+
+// Private helper methods for business logic
+
+// checkProject runs a permission check against a project. Missing projects
+// are not allowed.
+func (s *ProjectService) checkProject(userID string, projectID uint, check func(userID string, project *db.BaseProject) (bool, error)) (bool, error) {
+	var project db.BaseProject
+	if err := s.projectRepo.FindByID(projectID, &project); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return check(userID, &project)
+}
 
-	// Business rule: active members with admin, manager roles can create projects
-	return member.Role == "admin" || member.Role == "manager" || member.Role == "owner", nil
+// userCanCreateInCompany allows the owner and roles with the create_projects
+// permission
+func (s *ProjectService) userCanCreateInCompany(userID, companyID string) (bool, error) {
+	allowed, err := companies.HasPermission(s.database, companyID, userID, companies.CreateProjectsPermission)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return allowed, err
 }
 
 func (s *ProjectService) userCanAccessProject(userID string, project *db.BaseProject) (bool, error) {
@@ -257,11 +522,20 @@ func (s *ProjectService) userCanAccessProject(userID string, project *db.BasePro
 
 	// Check if user is a project member
 	var member db.ProjectMember
-	err := s.memberRepo.FindOne(&member, "project_id = ? AND user_id = ?", strconv.Itoa(int(project.ID)), userID)
+	err := s.memberRepo.FindOne(&member, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(project.ID)), "core", userID)
 	if err == nil {
 		return true, nil
 	}
 
+	// Check if one of the user's teams was granted access
+	grants, err := s.getUserTeamGrants(userID, project.ID)
+	if err != nil {
+		return false, err
+	}
+	if len(grants) > 0 {
+		return true, nil
+	}
+
 	// Check if user is in the same company (if project belongs to a company)
 	if project.CompanyID != nil {
 		var companyMember db.CompanyMember
@@ -271,7 +545,12 @@ func (s *ProjectService) userCanAccessProject(userID string, project *db.BasePro
 		}
 	}
 
-	return false, nil
+	// Access is inherited down the project tree
+	parent, err := s.getParentProject(project)
+	if err != nil || parent == nil {
+		return false, err
+	}
+	return s.userCanAccessProject(userID, parent)
 }
 
 func (s *ProjectService) userCanUpdateProject(userID string, project *db.BaseProject) (bool, error) {
@@ -282,7 +561,7 @@ func (s *ProjectService) userCanUpdateProject(userID string, project *db.BasePro
 
 	// Check if user is a project member with update permissions
 	var member db.ProjectMember
-	err := s.memberRepo.FindOne(&member, "project_id = ? AND user_id = ?", strconv.Itoa(int(project.ID)), userID)
+	err := s.memberRepo.FindOne(&member, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(project.ID)), "core", userID)
 	if err == nil {
 		// Check if member has update permission
 		for _, permission := range member.Permissions {
@@ -292,7 +571,21 @@ func (s *ProjectService) userCanUpdateProject(userID string, project *db.BasePro
 		}
 	}
 
-	return false, nil
+	// Check if one of the user's teams was granted update permissions
+	grants, err := s.getUserTeamGrants(userID, project.ID)
+	if err != nil {
+		return false, err
+	}
+	if grantsHavePermission(grants, "update", "admin") {
+		return true, nil
+	}
+
+	// Update permissions are inherited down the project tree
+	parent, err := s.getParentProject(project)
+	if err != nil || parent == nil {
+		return false, err
+	}
+	return s.userCanUpdateProject(userID, parent)
 }
 
 func (s *ProjectService) userCanManageProjectMembers(userID string, project *db.BaseProject) (bool, error) {
@@ -303,7 +596,7 @@ func (s *ProjectService) userCanManageProjectMembers(userID string, project *db.
 
 	// Check if user has member management permissions
 	var member db.ProjectMember
-	err := s.memberRepo.FindOne(&member, "project_id = ? AND user_id = ?", strconv.Itoa(int(project.ID)), userID)
+	err := s.memberRepo.FindOne(&member, "project_id = ? AND project_type = ? AND user_id = ?", strconv.Itoa(int(project.ID)), "core", userID)
 	if err == nil {
 		for _, permission := range member.Permissions {
 			if permission == "manage_members" || permission == "admin" {
@@ -312,7 +605,279 @@ func (s *ProjectService) userCanManageProjectMembers(userID string, project *db.
 		}
 	}
 
-	return false, nil
+	// Check if one of the user's teams was granted member management permissions
+	grants, err := s.getUserTeamGrants(userID, project.ID)
+	if err != nil {
+		return false, err
+	}
+	if grantsHavePermission(grants, "manage_members", "admin") {
+		return true, nil
+	}
+
+	// Member management permissions are inherited down the project tree
+	parent, err := s.getParentProject(project)
+	if err != nil || parent == nil {
+		return false, err
+	}
+	return s.userCanManageProjectMembers(userID, parent)
+}
+
+// checkCanDelete allows only the owner to delete, and only projects without
+// sub-projects
+func (s *ProjectService) checkCanDelete(project *db.BaseProject, userID string) error {
+	if project.OwnerID != userID {
+		return errors.New("only project owner can delete project")
+	}
+
+	// Sub-projects must be deleted or moved first
+	var childCount int64
+	if err := s.projectRepo.Count(&childCount, "parent_id = ?", project.ID); err != nil {
+		return err
+	}
+	if childCount > 0 {
+		return errors.New("project has sub-projects")
+	}
+	return nil
+}
+
+// deleteProject removes a project with its team grants, core members and tags
+func deleteProject(tx *gorm.DB, project *db.BaseProject) error {
+	if err := tx.Where("project_id = ?", project.ID).Delete(&db.ProjectTeam{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("project_id = ? AND project_type = ?", strconv.Itoa(int(project.ID)), "core").Delete(&db.ProjectMember{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("project_id = ?", project.ID).Delete(&db.ProjectTag{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(project).Error
+}
+
+// getUserTeamGrants resolves the grants a project gives to teams the user belongs to.
+// Membership is read at check time, so joining or leaving a team, or the team's
+// company, takes effect immediately.
+func (s *ProjectService) getUserTeamGrants(userID string, projectID uint) ([]db.ProjectTeam, error) {
+	teamIDs, err := s.getUserTeamIDs(userID)
+	if err != nil || len(teamIDs) == 0 {
+		return nil, err
+	}
+
+	var grants []db.ProjectTeam
+	if err := s.projectTeamRepo.FindWhere(&grants, "project_id = ? AND team_id IN ?", projectID, teamIDs); err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// getUserTeamIDs lists the teams of the user, counting only teams of companies
+// where the user is still an active member
+func (s *ProjectService) getUserTeamIDs(userID string) ([]uint, error) {
+	var teamIDs []uint
+	err := s.database.Model(&db.TeamMember{}).
+		Joins("JOIN teams ON teams.id = team_members.team_id").
+		Joins("JOIN company_members ON company_members.company_id = teams.company_id AND company_members.user_id = team_members.user_id").
+		Where("team_members.user_id = ? AND company_members.status = ?", userID, "active").
+		Pluck("team_members.team_id", &teamIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
+func (s *ProjectService) getTeamProjects(userID string) ([]db.BaseProject, error) {
+	teamIDs, err := s.getUserTeamIDs(userID)
+	if err != nil || len(teamIDs) == 0 {
+		return nil, err
+	}
+
+	var grants []db.ProjectTeam
+	if err := s.projectTeamRepo.FindWhere(&grants, "team_id IN ?", teamIDs); err != nil {
+		return nil, err
+	}
+	if len(grants) == 0 {
+		return nil, nil
+	}
+
+	projectIDs := make([]uint, len(grants))
+	for i, grant := range grants {
+		projectIDs[i] = grant.ProjectID
+	}
+
+	var projects []db.BaseProject
+	if err := s.projectRepo.FindWhere(&projects, "id IN ?", projectIDs); err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// notify delivers in-app notifications. Delivery is best effort: the change
+// that caused it has already been saved, so failures are only logged.
+func (s *ProjectService) notify(userIDs []string, notification db.Notification) {
+	if s.notificationService == nil {
+		return
+	}
+	if _, err := s.notificationService.Notify(userIDs, notification); err != nil {
+		log.Error("notify:failed", "type", notification.Type, "error", err)
+	}
+}
+
+// recordEvent adds a change to the event stream. Like notify, it runs after
+// the change was saved and only logs failures.
+func (s *ProjectService) recordEvent(event db.ChangeEvent) {
+	if s.eventService == nil {
+		return
+	}
+	if err := s.eventService.Record(&event); err != nil {
+		log.Error("record-event:failed", "action", event.Action, "error", err)
+	}
+}
+
+// notifyProjectStatusChanged tells the owner and core members, except the user who made the change
+func (s *ProjectService) notifyProjectStatusChanged(project *db.BaseProject, oldStatus, changedBy string) {
+	var members []db.ProjectMember
+	if err := s.memberRepo.FindWhere(&members, "project_id = ? AND project_type = ?", strconv.Itoa(int(project.ID)), "core"); err != nil {
+		log.Error("notify:failed", "type", notifications.TypeProjectStatusChanged, "error", err)
+		return
+	}
+
+	var recipients []string
+	if project.OwnerID != changedBy {
+		recipients = append(recipients, project.OwnerID)
+	}
+	for _, member := range members {
+		if member.UserID != changedBy {
+			recipients = append(recipients, member.UserID)
+		}
+	}
+	s.notify(recipients, notifications.ProjectStatusChanged(project, oldStatus))
+}
+
+// completedAt tracks when a project was completed as its status changes
+func completedAt(oldStatus, newStatus string, current *time.Time) *time.Time {
+	if newStatus != "completed" {
+		return nil
+	}
+	if oldStatus == "completed" {
+		return current
+	}
+	now := time.Now()
+	return &now
+}
+
+func grantsHavePermission(grants []db.ProjectTeam, allowed ...string) bool {
+	for _, grant := range grants {
+		for _, permission := range grant.Permissions {
+			for _, a := range allowed {
+				if permission == a {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// applyCustomFields validates input against the custom fields of the
+// project's company and project type and merges it into the project's values
+func (s *ProjectService) applyCustomFields(project *db.BaseProject, input map[string]interface{}) error {
+	definitions, err := s.customFieldDefinitions(project)
+	if err != nil {
+		return err
+	}
+	values, err := customfields.ApplyValues(definitions, project.CustomFields, input)
+	if err != nil {
+		return err
+	}
+	project.CustomFields = values
+	return nil
+}
+
+// customFieldDefinitions lists the company definitions before the project
+// type ones, so a project type definition wins when both use the same key
+func (s *ProjectService) customFieldDefinitions(project *db.BaseProject) ([]db.CustomFieldDefinition, error) {
+	var definitions []db.CustomFieldDefinition
+	if project.CompanyID != nil {
+		if err := s.definitionRepo.FindWhere(&definitions, "company_id = ?", *project.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+	if project.ProjectType != nil {
+		var typeDefinitions []db.CustomFieldDefinition
+		if err := s.definitionRepo.FindWhere(&typeDefinitions, "project_type = ?", *project.ProjectType); err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, typeDefinitions...)
+	}
+	return definitions, nil
+}
+
+func filterProjects(projects []db.BaseProject, filter ProjectFilter) []db.BaseProject {
+	if len(filter.CustomFields) == 0 {
+		return projects
+	}
+
+	var result []db.BaseProject
+	for _, project := range projects {
+		matches := true
+		for key, want := range filter.CustomFields {
+			if !customfields.MatchesValue(project.CustomFields[key], want) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result = append(result, project)
+		}
+	}
+	return result
+}
+
+// filterProjectsByTags keeps projects carrying every named tag. Only tags the
+// user can see count: company tags and the user's own personal tags.
+func (s *ProjectService) filterProjectsByTags(projects []db.BaseProject, tags []string, userID string) ([]db.BaseProject, error) {
+	if len(projects) == 0 {
+		return projects, nil
+	}
+
+	names := make(map[string]bool)
+	for _, tag := range tags {
+		names[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+	lowered := make([]string, 0, len(names))
+	for name := range names {
+		lowered = append(lowered, name)
+	}
+	projectIDs := make([]uint, len(projects))
+	for i, project := range projects {
+		projectIDs[i] = project.ID
+	}
+
+	var matchingIDs []uint
+	err := s.database.Model(&db.ProjectTag{}).
+		Joins("JOIN tags ON tags.id = project_tags.tag_id").
+		Where("project_tags.project_id IN ? AND LOWER(tags.name) IN ?", projectIDs, lowered).
+		Where("tags.company_id IS NOT NULL OR tags.owner_id = ?", userID).
+		Group("project_tags.project_id").
+		Having("COUNT(DISTINCT LOWER(tags.name)) = ?", len(lowered)).
+		Pluck("project_tags.project_id", &matchingIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make(map[uint]bool)
+	for _, id := range matchingIDs {
+		matching[id] = true
+	}
+	var result []db.BaseProject
+	for _, project := range projects {
+		if matching[project.ID] {
+			result = append(result, project)
+		}
+	}
+	return result, nil
 }
 
 func (s *ProjectService) deduplicateProjects(projects []db.BaseProject) []db.BaseProject {
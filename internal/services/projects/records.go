@@ -0,0 +1,405 @@
+package projects
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProjectRecord is the portable form of a project used by export and import.
+// ID and CompanyID are informational on import: the import scope decides the
+// company and ExternalKey decides which project is updated.
+type ProjectRecord struct {
+	ID          uint
+	ExternalKey string
+	Title       string
+	Description *string
+	Status      string
+	StartDate   *time.Time
+	EndDate     *time.Time
+	CompanyID   *string
+	ProjectType *string // Only applied when the import creates the project
+	Members     []MemberRecord
+
+	CustomFields map[string]interface{} // Listed values are set, other values are kept
+}
+
+type MemberRecord struct {
+	UserID      string
+	Role        string
+	Permissions []string
+}
+
+// RecordError rejects a single record; the import continues with the next one
+type RecordError struct {
+	Message string
+}
+
+func (e *RecordError) Error() string {
+	return e.Message
+}
+
+// RecordReader streams records one at a time and returns io.EOF at the end
+type RecordReader interface {
+	Read() (*ProjectRecord, error)
+}
+
+type RecordWriter interface {
+	Write(record *ProjectRecord) error
+	Close() error
+}
+
+// CSV columns, in export order. Members are encoded as
+// "userId:role:perm1|perm2" entries separated by ";", and custom fields as a
+// JSON object.
+var csvColumns = []string{"id", "external_key", "title", "description", "status", "start_date", "end_date", "company_id", "project_type", "members", "custom_fields"}
+
+/* ------------------------------------------------------------------ */
+/*  CSV                                                               */
+/* ------------------------------------------------------------------ */
+
+type csvRecordReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// NewCSVRecordReader reads the header row and then one record per row.
+// Columns are matched by name, so their order and any extra columns do not matter.
+func NewCSVRecordReader(r io.Reader) (RecordReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("import file is empty")
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"external_key", "title"} {
+		if _, ok := columns[required]; !ok {
+			return nil, errors.New("import file is missing column " + required)
+		}
+	}
+
+	return &csvRecordReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvRecordReader) Read() (*ProjectRecord, error) {
+	row, err := r.reader.Read()
+	if err != nil {
+		// The reader moves past a malformed row, so only that row fails
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &RecordError{Message: err.Error()}
+		}
+		return nil, err
+	}
+
+	field := func(name string) string {
+		i, ok := r.columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	record := &ProjectRecord{
+		ExternalKey: field("external_key"),
+		Title:       field("title"),
+		Status:      field("status"),
+	}
+	if description := field("description"); description != "" {
+		record.Description = &description
+	}
+	if companyID := field("company_id"); companyID != "" {
+		record.CompanyID = &companyID
+	}
+	if projectType := field("project_type"); projectType != "" {
+		record.ProjectType = &projectType
+	}
+	if record.StartDate, err = parseRecordDate(field("start_date")); err != nil {
+		return nil, &RecordError{Message: "invalid start_date"}
+	}
+	if record.EndDate, err = parseRecordDate(field("end_date")); err != nil {
+		return nil, &RecordError{Message: "invalid end_date"}
+	}
+	if record.Members, err = parseMembers(field("members")); err != nil {
+		return nil, &RecordError{Message: err.Error()}
+	}
+	if customFields := field("custom_fields"); customFields != "" {
+		if err := json.Unmarshal([]byte(customFields), &record.CustomFields); err != nil {
+			return nil, &RecordError{Message: "invalid custom_fields, expected a JSON object"}
+		}
+	}
+
+	return record, nil
+}
+
+type csvRecordWriter struct {
+	writer *csv.Writer
+	header bool
+}
+
+func NewCSVRecordWriter(w io.Writer) RecordWriter {
+	return &csvRecordWriter{writer: csv.NewWriter(w)}
+}
+
+func (w *csvRecordWriter) Write(record *ProjectRecord) error {
+	if !w.header {
+		w.header = true
+		if err := w.writer.Write(csvColumns); err != nil {
+			return err
+		}
+	}
+
+	members := make([]string, len(record.Members))
+	for i, member := range record.Members {
+		members[i] = member.UserID + ":" + member.Role
+		if len(member.Permissions) > 0 {
+			members[i] += ":" + strings.Join(member.Permissions, "|")
+		}
+	}
+
+	customFields := ""
+	if len(record.CustomFields) > 0 {
+		data, err := json.Marshal(record.CustomFields)
+		if err != nil {
+			return err
+		}
+		customFields = string(data)
+	}
+
+	return w.writer.Write([]string{
+		strconv.FormatUint(uint64(record.ID), 10),
+		record.ExternalKey,
+		record.Title,
+		stringValue(record.Description),
+		record.Status,
+		formatRecordDate(record.StartDate),
+		formatRecordDate(record.EndDate),
+		stringValue(record.CompanyID),
+		stringValue(record.ProjectType),
+		strings.Join(members, ";"),
+		customFields,
+	})
+}
+
+func (w *csvRecordWriter) Close() error {
+	// An empty export still gets its header row
+	if !w.header {
+		w.header = true
+		if err := w.writer.Write(csvColumns); err != nil {
+			return err
+		}
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+/* ------------------------------------------------------------------ */
+/*  JSON                                                              */
+/* ------------------------------------------------------------------ */
+
+type jsonRecord struct {
+	ID          uint               `json:"id,omitempty"`
+	ExternalKey string             `json:"externalKey"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description,omitempty"`
+	Status      string             `json:"status"`
+	StartDate   string             `json:"startDate,omitempty"`
+	EndDate     string             `json:"endDate,omitempty"`
+	CompanyID   *string            `json:"companyId,omitempty"`
+	ProjectType *string            `json:"projectType,omitempty"`
+	Members     []jsonMemberRecord `json:"members"`
+
+	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+}
+
+type jsonMemberRecord struct {
+	UserID      string   `json:"userId"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+type jsonRecordReader struct {
+	decoder *json.Decoder
+}
+
+// NewJSONRecordReader expects a top-level array and decodes one element at a time
+func NewJSONRecordReader(r io.Reader) (RecordReader, error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("import file is empty")
+		}
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("import file must contain a JSON array")
+	}
+	return &jsonRecordReader{decoder: decoder}, nil
+}
+
+func (r *jsonRecordReader) Read() (*ProjectRecord, error) {
+	if !r.decoder.More() {
+		if _, err := r.decoder.Token(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	// Syntax errors end the import; a well-formed element with bad values only rejects that element
+	var raw json.RawMessage
+	if err := r.decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+	var element jsonRecord
+	if err := json.Unmarshal(raw, &element); err != nil {
+		return nil, &RecordError{Message: err.Error()}
+	}
+
+	record := &ProjectRecord{
+		ExternalKey:  strings.TrimSpace(element.ExternalKey),
+		Title:        strings.TrimSpace(element.Title),
+		Description:  element.Description,
+		Status:       element.Status,
+		CompanyID:    element.CompanyID,
+		ProjectType:  element.ProjectType,
+		CustomFields: element.CustomFields,
+	}
+	var err error
+	if record.StartDate, err = parseRecordDate(element.StartDate); err != nil {
+		return nil, &RecordError{Message: "invalid startDate"}
+	}
+	if record.EndDate, err = parseRecordDate(element.EndDate); err != nil {
+		return nil, &RecordError{Message: "invalid endDate"}
+	}
+	for _, member := range element.Members {
+		record.Members = append(record.Members, MemberRecord(member))
+	}
+
+	return record, nil
+}
+
+type jsonRecordWriter struct {
+	writer io.Writer
+	count  int
+}
+
+// NewJSONRecordWriter writes a JSON array one element at a time
+func NewJSONRecordWriter(w io.Writer) RecordWriter {
+	return &jsonRecordWriter{writer: w}
+}
+
+func (w *jsonRecordWriter) Write(record *ProjectRecord) error {
+	element := jsonRecord{
+		ID:           record.ID,
+		ExternalKey:  record.ExternalKey,
+		Title:        record.Title,
+		Description:  record.Description,
+		Status:       record.Status,
+		StartDate:    formatRecordDate(record.StartDate),
+		EndDate:      formatRecordDate(record.EndDate),
+		CompanyID:    record.CompanyID,
+		ProjectType:  record.ProjectType,
+		Members:      make([]jsonMemberRecord, len(record.Members)),
+		CustomFields: record.CustomFields,
+	}
+	for i, member := range record.Members {
+		element.Members[i] = jsonMemberRecord(member)
+	}
+
+	data, err := json.Marshal(element)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if w.count == 0 {
+		separator = "[\n"
+	}
+	w.count++
+	if _, err := io.WriteString(w.writer, separator); err != nil {
+		return err
+	}
+	_, err = w.writer.Write(data)
+	return err
+}
+
+func (w *jsonRecordWriter) Close() error {
+	closing := "\n]\n"
+	if w.count == 0 {
+		closing = "[]\n"
+	}
+	_, err := io.WriteString(w.writer, closing)
+	return err
+}
+
+/* ------------------------------------------------------------------ */
+/*  Field encoding                                                    */
+/* ------------------------------------------------------------------ */
+
+// parseRecordDate accepts YYYY-MM-DD or RFC 3339; empty means no date
+func parseRecordDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339, value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func formatRecordDate(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+func parseMembers(value string) ([]MemberRecord, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var members []MemberRecord
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.New("invalid member entry " + strconv.Quote(entry) + ", expected userId:role[:permissions]")
+		}
+		member := MemberRecord{UserID: parts[0], Role: parts[1]}
+		if len(parts) == 3 && parts[2] != "" {
+			member.Permissions = strings.Split(parts[2], "|")
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,378 @@
+package projects
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"gorm.io/gorm"
+)
+
+// MaxImportRows caps the number of records a single import may process
+const MaxImportRows = 10000
+
+// exportBatchSize is the number of projects loaded per query during export
+const exportBatchSize = 200
+
+// ImportOptions sets the scope of an import. External keys are unique per
+// company, or per owner for personal projects (CompanyID nil).
+type ImportOptions struct {
+	CompanyID *string
+	DryRun    bool // Validate every row and report what would happen, without writing
+}
+
+type ImportRowResult struct {
+	Row         int // 1-based, not counting the CSV header
+	ExternalKey string
+	Action      string // create, update or skip
+	ProjectID   *uint  // Set for updates, and for creates outside dry runs
+	Error       string
+}
+
+type ImportResult struct {
+	DryRun  bool
+	Rows    []ImportRowResult
+	Created int
+	Updated int
+	Failed  int
+}
+
+// ExportProjects streams projects with their core members to writer. With a
+// company ID it exports every project of that company, otherwise the projects
+// the user owns or belongs to. Projects are read in pages ordered by ID, so
+// memory use does not grow with the number of projects.
+func (s *ProjectService) ExportProjects(companyID *string, userID string, writer RecordWriter) error {
+	var scope func(query *gorm.DB) *gorm.DB
+	if companyID != nil {
+		var member db.CompanyMember
+		if err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", *companyID, userID, "active"); err != nil {
+			return errors.New("user cannot access this company")
+		}
+		scope = func(query *gorm.DB) *gorm.DB {
+			return query.Where("company_id = ?", *companyID)
+		}
+	} else {
+		// Same projects as GetUserProjects: owned, core memberships and team grants
+		teamIDs, err := s.getUserTeamIDs(userID)
+		if err != nil {
+			return err
+		}
+		scope = func(query *gorm.DB) *gorm.DB {
+			memberProjects := s.database.Model(&db.ProjectMember{}).Select("project_id").Where("user_id = ? AND project_type = ?", userID, "core")
+			teamProjects := s.database.Model(&db.ProjectTeam{}).Select("project_id").Where("team_id IN ?", teamIDs)
+			return query.Where("owner_id = ? OR CAST(id AS TEXT) IN (?) OR id IN (?)", userID, memberProjects, teamProjects)
+		}
+	}
+
+	var lastID uint
+	for {
+		var batch []db.BaseProject
+		if err := s.database.Scopes(scope).Where("id > ?", lastID).Order("id").Limit(exportBatchSize).Find(&batch).Error; err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		lastID = batch[len(batch)-1].ID
+
+		projectIDs := make([]string, len(batch))
+		for i, project := range batch {
+			projectIDs[i] = strconv.Itoa(int(project.ID))
+		}
+		var members []db.ProjectMember
+		if err := s.memberRepo.FindWhere(&members, "project_type = ? AND project_id IN ?", "core", projectIDs); err != nil {
+			return err
+		}
+		membersByProject := make(map[string][]MemberRecord)
+		for _, member := range members {
+			membersByProject[member.ProjectID] = append(membersByProject[member.ProjectID], MemberRecord{
+				UserID:      member.UserID,
+				Role:        member.Role,
+				Permissions: member.Permissions,
+			})
+		}
+
+		for i := range batch {
+			record := projectToRecord(&batch[i])
+			record.Members = membersByProject[projectIDs[i]]
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		if len(batch) < exportBatchSize {
+			break
+		}
+	}
+
+	return writer.Close()
+}
+
+// ImportProjects reads every record, then upserts each by external key in its
+// own transaction, so one bad row never blocks the others. Members
+// listed on a row are added or updated; existing members not listed are kept.
+func (s *ProjectService) ImportProjects(reader RecordReader, options ImportOptions, userID string) (*ImportResult, error) {
+	if options.CompanyID != nil {
+		canCreate, err := s.userCanCreateInCompany(userID, *options.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		if !canCreate {
+			return nil, errors.New("user cannot create projects in this company")
+		}
+	}
+
+	// Records are read up front, so a file over the limit or one that breaks
+	// off mid-stream is rejected before any row is written
+	var records []*ProjectRecord
+	var readErrors []string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if len(records) == MaxImportRows {
+			return nil, errors.New("import exceeds maximum of " + strconv.Itoa(MaxImportRows) + " rows")
+		}
+		var message string
+		if err != nil {
+			var recordErr *RecordError
+			if !errors.As(err, &recordErr) {
+				return nil, err
+			}
+			message = recordErr.Message
+		}
+		records = append(records, record)
+		readErrors = append(readErrors, message)
+	}
+
+	result := &ImportResult{DryRun: options.DryRun}
+	seen := make(map[string]int)
+
+	for i, record := range records {
+		row := i + 1
+		rowResult := ImportRowResult{Row: row, Action: "skip"}
+		if readErrors[i] != "" {
+			rowResult.Error = readErrors[i]
+			result.Rows = append(result.Rows, rowResult)
+			continue
+		}
+		rowResult.ExternalKey = record.ExternalKey
+
+		// A key appearing twice would silently overwrite the earlier row
+		if first, ok := seen[record.ExternalKey]; ok && record.ExternalKey != "" {
+			rowResult.Error = "duplicate external key, first used on row " + strconv.Itoa(first)
+			result.Rows = append(result.Rows, rowResult)
+			continue
+		}
+		seen[record.ExternalKey] = row
+
+		if err := s.importRecord(record, options, userID, &rowResult); err != nil {
+			rowResult.Action = "skip"
+			rowResult.ProjectID = nil
+			rowResult.Error = err.Error()
+		}
+		result.Rows = append(result.Rows, rowResult)
+	}
+
+	for _, row := range result.Rows {
+		switch {
+		case row.Error != "":
+			result.Failed++
+		case row.Action == "create":
+			result.Created++
+		case row.Action == "update":
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
+
+// Private helper methods
+
+func (s *ProjectService) importRecord(record *ProjectRecord, options ImportOptions, userID string, rowResult *ImportRowResult) error {
+	if err := validateRecord(record); err != nil {
+		return err
+	}
+
+	var existing db.BaseProject
+	var err error
+	if options.CompanyID != nil {
+		err = s.projectRepo.FindOne(&existing, "company_id = ? AND external_key = ?", *options.CompanyID, record.ExternalKey)
+	} else {
+		err = s.projectRepo.FindOne(&existing, "company_id IS NULL AND owner_id = ? AND external_key = ?", userID, record.ExternalKey)
+	}
+	found := err == nil
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if found {
+		canUpdate, err := s.userCanUpdateProject(userID, &existing)
+		if err != nil {
+			return err
+		}
+		if !canUpdate {
+			return errors.New("user cannot update this project")
+		}
+		// Listed members are added or changed, which needs member management
+		if len(record.Members) > 0 {
+			canManage, err := s.userCanManageProjectMembers(userID, &existing)
+			if err != nil {
+				return err
+			}
+			if !canManage {
+				return errors.New("user cannot update members of this project")
+			}
+		}
+		rowResult.Action = "update"
+		rowResult.ProjectID = &existing.ID
+	} else {
+		rowResult.Action = "create"
+	}
+
+	// Records go through the same checks as CreateProject and UpdateProject.
+	// The project type is only set on create, as updates cannot change it.
+	now := time.Now()
+	project := &existing
+	if found {
+		project.Title = record.Title
+		project.Description = record.Description
+		project.CompletedAt = completedAt(project.Status, record.Status, project.CompletedAt)
+		project.Status = record.Status
+		project.StartDate = record.StartDate
+		project.EndDate = record.EndDate
+		if record.CustomFields != nil {
+			if err := s.applyCustomFields(project, record.CustomFields); err != nil {
+				return err
+			}
+		}
+		project.UpdatedAt = now
+	} else {
+		if record.ProjectType != nil {
+			var projectType db.ProjectType
+			if err := s.projectTypeRepo.FindByID(*record.ProjectType, &projectType); err != nil {
+				return errors.New("project type not found")
+			}
+		}
+		externalKey := record.ExternalKey
+		project = &db.BaseProject{
+			Title:       record.Title,
+			Description: record.Description,
+			Status:      record.Status,
+			OwnerID:     userID,
+			CompanyID:   options.CompanyID,
+			StartDate:   record.StartDate,
+			EndDate:     record.EndDate,
+			CompletedAt: completedAt("", record.Status, nil),
+			ExternalKey: &externalKey,
+			ProjectType: record.ProjectType,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err := s.applyCustomFields(project, record.CustomFields); err != nil {
+			return err
+		}
+	}
+
+	if options.DryRun {
+		return nil
+	}
+
+	err = s.database.WithTransaction(func(tx *gorm.DB) error {
+		if found {
+			if err := tx.Save(project).Error; err != nil {
+				return err
+			}
+		} else {
+			if err := tx.Create(project).Error; err != nil {
+				return err
+			}
+			rowResult.ProjectID = &project.ID
+		}
+
+		projectID := strconv.Itoa(int(project.ID))
+		for _, memberRecord := range record.Members {
+			var member db.ProjectMember
+			err := tx.Where("project_id = ? AND project_type = ? AND user_id = ?", projectID, "core", memberRecord.UserID).First(&member).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				member = db.ProjectMember{
+					ProjectID:   projectID,
+					ProjectType: "core",
+					UserID:      memberRecord.UserID,
+					Role:        memberRecord.Role,
+					Permissions: memberRecord.Permissions,
+					JoinedAt:    now,
+				}
+				if err := tx.Create(&member).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&db.ProjectMember{}).
+				Where("project_id = ? AND project_type = ? AND user_id = ?", projectID, "core", memberRecord.UserID).
+				Updates(map[string]interface{}{"role": memberRecord.Role, "permissions": memberRecord.Permissions}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if found {
+		s.recordEvent(events.ProjectEvent(events.ActionUpdated, project, userID))
+	} else {
+		s.recordEvent(events.ProjectEvent(events.ActionCreated, project, userID))
+	}
+	return nil
+}
+
+func validateRecord(record *ProjectRecord) error {
+	if record.ExternalKey == "" {
+		return errors.New("external key is required")
+	}
+	if record.Title == "" {
+		return errors.New("title is required")
+	}
+	if record.Status == "" {
+		record.Status = "active"
+	}
+	if !validProjectStatuses[record.Status] {
+		return errors.New("invalid project status")
+	}
+	if record.StartDate != nil && record.EndDate != nil && record.EndDate.Before(*record.StartDate) {
+		return errors.New("end date is before start date")
+	}
+	for _, member := range record.Members {
+		if member.UserID == "" || member.Role == "" {
+			return errors.New("member user ID and role are required")
+		}
+	}
+	return nil
+}
+
+func projectToRecord(project *db.BaseProject) *ProjectRecord {
+	record := &ProjectRecord{
+		ID:           project.ID,
+		Title:        project.Title,
+		Description:  project.Description,
+		Status:       project.Status,
+		StartDate:    project.StartDate,
+		EndDate:      project.EndDate,
+		CompanyID:    project.CompanyID,
+		ProjectType:  project.ProjectType,
+		CustomFields: project.CustomFields,
+	}
+	if project.ExternalKey != nil {
+		record.ExternalKey = *project.ExternalKey
+	}
+	return record
+}
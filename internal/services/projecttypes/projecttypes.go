@@ -0,0 +1,297 @@
+package projecttypes
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+const (
+	// CoreType is the built-in type of this module's own projects. Its members
+	// are managed through the project endpoints, which check permissions.
+	CoreType = "core"
+	// CoreService owns the core type
+	CoreService = "project-core"
+)
+
+var typeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+
+// Membership is a project membership together with the type it belongs to,
+// so callers know which service to ask about the project
+type Membership struct {
+	db.ProjectMember
+	Service     string
+	CallbackURL string
+}
+
+type ProjectTypeService struct {
+	database   *pgconnect.DB
+	typeRepo   *pgconnect.Repository[db.ProjectType]
+	memberRepo *pgconnect.Repository[db.ProjectMember]
+}
+
+func NewProjectTypeService(database *pgconnect.DB) *ProjectTypeService {
+	return &ProjectTypeService{
+		database:   database,
+		typeRepo:   pgconnect.NewRepository[db.ProjectType](database),
+		memberRepo: pgconnect.NewRepository[db.ProjectMember](database),
+	}
+}
+
+// RegisterProjectType adds a project type, or updates the callback URL of a
+// type the same service registered before, so modules can register on every
+// startup. created reports whether the type is new.
+func (s *ProjectTypeService) RegisterProjectType(projectType *db.ProjectType) (registered *db.ProjectType, created bool, err error) {
+	if err := validateProjectType(projectType); err != nil {
+		return nil, false, err
+	}
+
+	var existing db.ProjectType
+	err = s.typeRepo.FindByID(projectType.Name, &existing)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	now := time.Now()
+	if err == nil {
+		if existing.Service != projectType.Service {
+			return nil, false, errors.New("project type is registered by another service")
+		}
+		existing.CallbackURL = projectType.CallbackURL
+		existing.UpdatedAt = now
+		if err := s.typeRepo.Update(&existing); err != nil {
+			return nil, false, err
+		}
+		return &existing, false, nil
+	}
+
+	projectType.RegisteredAt = now
+	projectType.UpdatedAt = now
+	if err := s.typeRepo.Create(projectType); err != nil {
+		return nil, false, err
+	}
+	return projectType, true, nil
+}
+
+func (s *ProjectTypeService) GetProjectType(name string) (*db.ProjectType, error) {
+	if name == CoreType {
+		return coreProjectType(), nil
+	}
+
+	var projectType db.ProjectType
+	if err := s.typeRepo.FindByID(name, &projectType); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("project type not found")
+		}
+		return nil, err
+	}
+	return &projectType, nil
+}
+
+// ListProjectTypes returns the core type followed by the registered types by name
+func (s *ProjectTypeService) ListProjectTypes() ([]db.ProjectType, error) {
+	var projectTypes []db.ProjectType
+	if err := s.typeRepo.FindAll(&projectTypes); err != nil {
+		return nil, err
+	}
+	sort.Slice(projectTypes, func(i, j int) bool { return projectTypes[i].Name < projectTypes[j].Name })
+
+	return append([]db.ProjectType{*coreProjectType()}, projectTypes...), nil
+}
+
+// UnregisterProjectType removes a type once its service has removed every
+// membership of it
+func (s *ProjectTypeService) UnregisterProjectType(name, service string) error {
+	projectType, err := s.getExternalType(name)
+	if err != nil {
+		return err
+	}
+	if projectType.Service != service {
+		return errors.New("project type is registered by another service")
+	}
+
+	var memberCount int64
+	if err := s.memberRepo.Count(&memberCount, "project_type = ?", name); err != nil {
+		return err
+	}
+	if memberCount > 0 {
+		return errors.New("project type has members")
+	}
+
+	return s.typeRepo.Delete(projectType)
+}
+
+// External project members. The owning service decides who may change them;
+// these methods only check that the type is registered.
+
+func (s *ProjectTypeService) GetProjectMembers(projectTypeName, projectID string) ([]db.ProjectMember, error) {
+	if _, err := s.getExternalType(projectTypeName); err != nil {
+		return nil, err
+	}
+
+	var members []db.ProjectMember
+	if err := s.database.Where("project_type = ? AND project_id = ?", projectTypeName, projectID).Order("joined_at").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (s *ProjectTypeService) AddProjectMember(member *db.ProjectMember) (*db.ProjectMember, error) {
+	if _, err := s.getExternalType(member.ProjectType); err != nil {
+		return nil, err
+	}
+	if member.ProjectID == "" {
+		return nil, errors.New("project ID is required")
+	}
+
+	var existing db.ProjectMember
+	err := s.memberRepo.FindOne(&existing, "project_type = ? AND project_id = ? AND user_id = ?", member.ProjectType, member.ProjectID, member.UserID)
+	if err == nil {
+		return nil, errors.New("user is already a member of this project")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	member.JoinedAt = time.Now()
+	if err := s.memberRepo.Create(member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// UpdateProjectMember changes a member's role and permissions. An empty role
+// or nil permissions keep the current value.
+func (s *ProjectTypeService) UpdateProjectMember(projectTypeName, projectID, userID, role string, permissions []string) (*db.ProjectMember, error) {
+	if _, err := s.getExternalType(projectTypeName); err != nil {
+		return nil, err
+	}
+
+	var member db.ProjectMember
+	if err := s.memberRepo.FindOne(&member, "project_type = ? AND project_id = ? AND user_id = ?", projectTypeName, projectID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user is not a member of this project")
+		}
+		return nil, err
+	}
+
+	if role != "" {
+		member.Role = role
+	}
+	if permissions != nil {
+		member.Permissions = permissions
+	}
+
+	// ProjectMember has no primary key, so the row is matched explicitly
+	err := s.database.Model(&db.ProjectMember{}).
+		Where("project_type = ? AND project_id = ? AND user_id = ?", projectTypeName, projectID, userID).
+		Updates(map[string]interface{}{"role": member.Role, "permissions": member.Permissions}).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (s *ProjectTypeService) RemoveProjectMember(projectTypeName, projectID, userID string) error {
+	if _, err := s.getExternalType(projectTypeName); err != nil {
+		return err
+	}
+
+	result := s.database.Where("project_type = ? AND project_id = ? AND user_id = ?", projectTypeName, projectID, userID).Delete(&db.ProjectMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("user is not a member of this project")
+	}
+	return nil
+}
+
+// GetUserMemberships lists the user's memberships across every project type,
+// core included, or of one type when projectTypeName is set. Memberships of
+// types that are no longer registered are left out.
+func (s *ProjectTypeService) GetUserMemberships(userID, projectTypeName string) ([]Membership, error) {
+	projectTypes, err := s.ListProjectTypes()
+	if err != nil {
+		return nil, err
+	}
+	typesByName := make(map[string]db.ProjectType, len(projectTypes))
+	for _, projectType := range projectTypes {
+		typesByName[projectType.Name] = projectType
+	}
+
+	query := s.database.Where("user_id = ?", userID)
+	if projectTypeName != "" {
+		if _, ok := typesByName[projectTypeName]; !ok {
+			return nil, errors.New("project type not found")
+		}
+		query = query.Where("project_type = ?", projectTypeName)
+	}
+
+	var members []db.ProjectMember
+	if err := query.Order("project_type, joined_at").Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	memberships := make([]Membership, 0, len(members))
+	for _, member := range members {
+		projectType, ok := typesByName[member.ProjectType]
+		if !ok {
+			continue
+		}
+		memberships = append(memberships, Membership{
+			ProjectMember: member,
+			Service:       projectType.Service,
+			CallbackURL:   projectType.CallbackURL,
+		})
+	}
+	return memberships, nil
+}
+
+// Private helper methods
+
+// getExternalType loads a registered type; core is rejected because its
+// members are managed through the project endpoints
+func (s *ProjectTypeService) getExternalType(name string) (*db.ProjectType, error) {
+	if name == CoreType {
+		return nil, errors.New("core project members are managed through the project endpoints")
+	}
+
+	var projectType db.ProjectType
+	if err := s.typeRepo.FindByID(name, &projectType); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("project type not found")
+		}
+		return nil, err
+	}
+	return &projectType, nil
+}
+
+func coreProjectType() *db.ProjectType {
+	return &db.ProjectType{Name: CoreType, Service: CoreService}
+}
+
+func validateProjectType(projectType *db.ProjectType) error {
+	if projectType.Name == CoreType {
+		return errors.New("project type name is reserved")
+	}
+	if !typeNamePattern.MatchString(projectType.Name) {
+		return errors.New("invalid project type name")
+	}
+	if projectType.Service == "" {
+		return errors.New("service is required")
+	}
+	if projectType.CallbackURL != "" {
+		callback, err := url.Parse(projectType.CallbackURL)
+		if err != nil || (callback.Scheme != "http" && callback.Scheme != "https") || callback.Host == "" {
+			return errors.New("invalid callback URL")
+		}
+	}
+	return nil
+}
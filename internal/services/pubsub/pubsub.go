@@ -0,0 +1,165 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/pgconnect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "PubSub"),
+)
+
+// Reconnect backoff of the listener
+const (
+	firstReconnectDelay = time.Second
+	maxReconnectDelay   = 30 * time.Second
+)
+
+// PubSub fans messages out to every replica through PostgreSQL LISTEN/NOTIFY.
+// Payloads are limited to 8000 bytes, so messages carry IDs, not data.
+// Delivery is at most once: notifications sent while the listener is
+// reconnecting are lost, which OnReconnect hooks make up for.
+type PubSub struct {
+	database *pgconnect.DB
+
+	mu          sync.RWMutex
+	handlers    map[string][]func(payload string)
+	reconnected []func()
+}
+
+func NewPubSub(database *pgconnect.DB) *PubSub {
+	return &PubSub{
+		database: database,
+		handlers: make(map[string][]func(payload string)),
+	}
+}
+
+// Subscribe registers a handler for a channel. Handlers run on the listener
+// goroutine, so they must return quickly. Subscribe before Start.
+func (p *PubSub) Subscribe(channel string, handler func(payload string)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers[channel] = append(p.handlers[channel], handler)
+}
+
+// OnReconnect registers a function called each time the listener reconnects
+// after losing its connection
+func (p *PubSub) OnReconnect(fn func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reconnected = append(p.reconnected, fn)
+}
+
+// Publish sends a message to the channel on every replica, this one included
+func (p *PubSub) Publish(ctx context.Context, channel, payload string) error {
+	return p.database.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", channel, payload).Error
+}
+
+// Start listens on a dedicated connection until ctx ends, reconnecting with
+// backoff when the connection is lost
+func (p *PubSub) Start(ctx context.Context) {
+	go func() {
+		delay := firstReconnectDelay
+		connected := false
+		for {
+			err := p.listen(ctx, func() {
+				if connected {
+					p.runReconnectHooks()
+				}
+				connected = true
+				delay = firstReconnectDelay
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error("listen:failed", "error", err, "retryIn", delay)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = min(delay*2, maxReconnectDelay)
+		}
+	}()
+}
+
+// Private helper methods
+
+// listen holds one pooled connection for LISTEN and dispatches notifications
+// until an error occurs. connected is called once every channel is listened to.
+func (p *PubSub) listen(ctx context.Context, connected func()) error {
+	sqlDB, err := p.database.DB.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("LISTEN requires the pgx database driver")
+		}
+		pgxConn := stdlibConn.Conn()
+
+		p.mu.RLock()
+		channels := make([]string, 0, len(p.handlers))
+		for channel := range p.handlers {
+			channels = append(channels, channel)
+		}
+		p.mu.RUnlock()
+
+		for _, channel := range channels {
+			if _, err := pgxConn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+				return err
+			}
+		}
+		connected()
+
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				// The connection goes back to the pool, so it must stop listening;
+				// if it cannot, it is discarded
+				if _, unlistenErr := pgxConn.Exec(context.Background(), "UNLISTEN *"); unlistenErr != nil {
+					return driver.ErrBadConn
+				}
+				return err
+			}
+			p.dispatch(notification.Channel, notification.Payload)
+		}
+	})
+}
+
+func (p *PubSub) dispatch(channel, payload string) {
+	p.mu.RLock()
+	handlers := p.handlers[channel]
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(payload)
+	}
+}
+
+func (p *PubSub) runReconnectHooks() {
+	p.mu.RLock()
+	hooks := p.reconnected
+	p.mu.RUnlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+}
@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/notifications"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+// DefaultLeadTimes are used when no lead times are configured
+const DefaultLeadTimes = "7d,1d"
+
+// OverdueLookback bounds how far back overdue projects are scanned. Projects
+// overdue for longer were already reported, unless the scheduler was down.
+const OverdueLookback = 7 * 24 * time.Hour
+
+// DeadlineJob reminds owners and members of active projects that their end
+// date is approaching, and tells them once it has passed.
+type DeadlineJob struct {
+	notificationService *notifications.NotificationService
+	projectRepo         *pgconnect.Repository[db.BaseProject]
+	memberRepo          *pgconnect.Repository[db.ProjectMember]
+	leadTimes           []time.Duration // Ascending
+}
+
+// NewDeadlineJob creates the job. A project gets at most one reminder per lead
+// time, and only for the shortest lead time it falls within when scanned, so a
+// project created a day before its deadline doesn't also get the week reminder.
+func NewDeadlineJob(database *pgconnect.DB, notificationService *notifications.NotificationService, leadTimes []time.Duration) *DeadlineJob {
+	sorted := slices.Clone(leadTimes)
+	slices.Sort(sorted)
+	return &DeadlineJob{
+		notificationService: notificationService,
+		projectRepo:         pgconnect.NewRepository[db.BaseProject](database),
+		memberRepo:          pgconnect.NewRepository[db.ProjectMember](database),
+		leadTimes:           sorted,
+	}
+}
+
+// Job wraps the deadline check for the scheduler
+func (j *DeadlineJob) Job(interval time.Duration) Job {
+	return Job{
+		Name:     "deadlines",
+		Interval: interval,
+		Run:      j.Run,
+	}
+}
+
+func (j *DeadlineJob) Run(ctx context.Context) error {
+	now := time.Now()
+	horizon := now
+	if len(j.leadTimes) > 0 {
+		horizon = now.Add(j.leadTimes[len(j.leadTimes)-1])
+	}
+
+	var projects []db.BaseProject
+	if err := j.projectRepo.FindWhere(&projects, "status = ? AND end_date IS NOT NULL AND end_date > ? AND end_date <= ?",
+		"active", now.Add(-OverdueLookback), horizon); err != nil {
+		return err
+	}
+
+	for i := range projects {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := j.checkProject(&projects[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseLeadTimes parses a comma-separated list of durations such as
+// "7d,1d,2h". Days are accepted in addition to time.ParseDuration units.
+func ParseLeadTimes(value string) ([]time.Duration, error) {
+	var leadTimes []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var leadTime time.Duration
+		if days, ok := strings.CutSuffix(part, "d"); ok {
+			n, err := strconv.Atoi(days)
+			if err != nil {
+				return nil, errors.New("invalid lead time: " + part)
+			}
+			leadTime = time.Duration(n) * 24 * time.Hour
+		} else {
+			var err error
+			if leadTime, err = time.ParseDuration(part); err != nil {
+				return nil, errors.New("invalid lead time: " + part)
+			}
+		}
+		if leadTime <= 0 {
+			return nil, errors.New("lead times must be positive")
+		}
+		leadTimes = append(leadTimes, leadTime)
+	}
+	return leadTimes, nil
+}
+
+// Private helper methods
+
+func (j *DeadlineJob) checkProject(project *db.BaseProject, now time.Time) error {
+	remaining := project.EndDate.Sub(now)
+
+	var notification db.Notification
+	if remaining <= 0 {
+		notification = notifications.ProjectOverdue(project)
+	} else {
+		index := slices.IndexFunc(j.leadTimes, func(leadTime time.Duration) bool { return remaining <= leadTime })
+		if index < 0 {
+			return nil
+		}
+		notification = notifications.DeadlineApproaching(project, j.leadTimes[index])
+	}
+
+	recipients, err := j.recipients(project)
+	if err != nil {
+		return err
+	}
+	_, err = j.notificationService.Notify(recipients, notification)
+	return err
+}
+
+func (j *DeadlineJob) recipients(project *db.BaseProject) ([]string, error) {
+	var members []db.ProjectMember
+	if err := j.memberRepo.FindWhere(&members, "project_id = ? AND project_type = ?", strconv.Itoa(int(project.ID)), "core"); err != nil {
+		return nil, err
+	}
+
+	recipients := []string{project.OwnerID}
+	for _, member := range members {
+		recipients = append(recipients, member.UserID)
+	}
+	return recipients, nil
+}
@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "Scheduler"),
+)
+
+// Job is a unit of background work run on a fixed interval. Name identifies
+// the job's database lock, so it must be the same on every replica.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs jobs in the background. Each run holds a PostgreSQL advisory
+// lock on the job name, so with several replicas only one of them runs a job
+// at a time and the others skip that tick.
+type Scheduler struct {
+	database *pgconnect.DB
+	jobs     []Job
+}
+
+func NewScheduler(database *pgconnect.DB) *Scheduler {
+	return &Scheduler{
+		database: database,
+	}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every job once and then on its interval until ctx is cancelled.
+// It returns immediately.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+}
+
+// RunOnce runs a job if no other replica is running it. ran reports whether
+// the lock was acquired.
+func (s *Scheduler) RunOnce(ctx context.Context, job Job) (ran bool, err error) {
+	sqlDB, err := s.database.DB.DB()
+	if err != nil {
+		return false, err
+	}
+
+	// Session-level advisory locks belong to a connection, so lock and unlock
+	// must use the same one. The lock is also released if the connection drops.
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockName(job.Name)).Scan(&locked); err != nil {
+		return false, err
+	}
+	if !locked {
+		return false, nil
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even after cancellation
+		if _, unlockErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", lockName(job.Name)); unlockErr != nil {
+			err = errors.Join(err, unlockErr)
+		}
+	}()
+
+	return true, job.Run(ctx)
+}
+
+// Private helper methods
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		ran, err := s.RunOnce(ctx, job)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Error("job-failed", "job", job.Name, "error", err)
+		case ran:
+			log.Info("job-completed", "job", job.Name, "duration", time.Since(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func lockName(jobName string) string {
+	return "project-core:scheduler:" + jobName
+}
@@ -0,0 +1,297 @@
+package search
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+const (
+	// DefaultLimit and MaxLimit bound the hits returned per result type
+	DefaultLimit = 20
+	MaxLimit     = 100
+	// MaxTerms caps the number of words taken from a query
+	MaxTerms = 10
+)
+
+// Result types that can be requested
+const (
+	TypeProjects  = "projects"
+	TypeCompanies = "companies"
+	TypeMembers   = "members"
+)
+
+// Highlight options shared by every ts_headline call
+const headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5"
+
+// The 'simple' configuration does no stemming, so ids, names and any
+// language match the same way. Expressions must be immutable to be used in
+// generated columns, hence the explicit regconfig.
+var migrations = []string{
+	`ALTER TABLE base_projects ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple'::regconfig, coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('simple'::regconfig, coalesce(description, '')), 'B')
+		) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_base_projects_search ON base_projects USING GIN (search_vector)`,
+	`ALTER TABLE companies ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple'::regconfig, coalesce(name, '')), 'A') ||
+			setweight(to_tsvector('simple'::regconfig, coalesce(type, '')), 'C')
+		) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_companies_search ON companies USING GIN (search_vector)`,
+	`ALTER TABLE company_members ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple'::regconfig, coalesce(user_id, '')), 'A') ||
+			setweight(to_tsvector('simple'::regconfig, coalesce(role, '')), 'B')
+		) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_company_members_search ON company_members USING GIN (search_vector)`,
+}
+
+// Migrate adds the generated tsvector columns and their GIN indexes. It is
+// idempotent and must run after the models are auto-migrated.
+func Migrate(database *pgconnect.DB) error {
+	for _, statement := range migrations {
+		if err := database.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SearchService struct {
+	database *pgconnect.DB
+}
+
+func NewSearchService(database *pgconnect.DB) *SearchService {
+	return &SearchService{
+		database: database,
+	}
+}
+
+type SearchOptions struct {
+	Types []string // Defaults to all types
+	Tags  []string // Tag names, ignoring case; project hits must have all of them
+	Limit int
+}
+
+type ProjectHit struct {
+	ID                   uint
+	Title                string
+	Status               string
+	CompanyID            *string
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+type CompanyHit struct {
+	ID            string
+	Name          string
+	Type          string
+	Rank          float64
+	NameHighlight string
+}
+
+type MemberHit struct {
+	CompanyID       string
+	UserID          string
+	Role            string
+	Rank            float64
+	UserIDHighlight string
+}
+
+type SearchResults struct {
+	Query     string
+	Projects  []ProjectHit
+	Companies []CompanyHit
+	Members   []MemberHit
+}
+
+func (s *SearchService) Search(query, userID string, options SearchOptions) (*SearchResults, error) {
+	tsQuery := prefixQuery(query)
+	if tsQuery == "" {
+		return nil, errors.New("search query is required")
+	}
+
+	if options.Limit <= 0 {
+		options.Limit = DefaultLimit
+	}
+	if options.Limit > MaxLimit {
+		options.Limit = MaxLimit
+	}
+	if len(options.Types) == 0 {
+		options.Types = []string{TypeProjects, TypeCompanies, TypeMembers}
+	}
+
+	results := &SearchResults{Query: query}
+	for _, resultType := range options.Types {
+		var err error
+		switch resultType {
+		case TypeProjects:
+			results.Projects, err = s.searchProjects(tsQuery, userID, options.Tags, options.Limit)
+		case TypeCompanies:
+			results.Companies, err = s.searchCompanies(tsQuery, userID, options.Limit)
+		case TypeMembers:
+			results.Members, err = s.searchMembers(tsQuery, userID, options.Limit)
+		default:
+			return nil, errors.New("invalid search type")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// Private helper methods
+
+// searchProjects applies the same rules as ProjectService.userCanAccessProject:
+// owners, project members, granted teams and active company members, with
+// access inherited down the project tree. Tags count when they are company
+// tags or the user's own personal tags.
+func (s *SearchService) searchProjects(tsQuery, userID string, tags []string, limit int) ([]ProjectHit, error) {
+	tagFilter := ""
+	names := tagNames(tags)
+	if len(names) > 0 {
+		tagFilter = `
+			AND (
+				SELECT COUNT(DISTINCT LOWER(t.name))
+				FROM project_tags ptg
+				JOIN tags t ON t.id = ptg.tag_id
+				WHERE ptg.project_id = bp.id AND LOWER(t.name) IN @tags
+					AND (t.company_id IS NOT NULL OR t.owner_id = @user)
+			) = @tagCount`
+	}
+
+	var hits []ProjectHit
+	err := s.database.Raw(`
+		WITH RECURSIVE accessible AS (
+			SELECT bp.id
+			FROM base_projects bp
+			WHERE bp.owner_id = @user
+				OR EXISTS (
+					SELECT 1 FROM project_members pm
+					WHERE pm.project_type = 'core' AND pm.project_id = CAST(bp.id AS TEXT) AND pm.user_id = @user
+				)
+				OR EXISTS (
+					SELECT 1 FROM project_teams pt
+					JOIN team_members tm ON tm.team_id = pt.team_id
+					JOIN teams t ON t.id = tm.team_id
+					JOIN company_members tcm ON tcm.company_id = t.company_id AND tcm.user_id = tm.user_id AND tcm.status = 'active'
+					WHERE pt.project_id = bp.id AND tm.user_id = @user
+				)
+				OR EXISTS (
+					SELECT 1 FROM company_members cm
+					WHERE cm.company_id = bp.company_id AND cm.user_id = @user AND cm.status = 'active'
+				)
+			UNION
+			SELECT child.id
+			FROM base_projects child
+			JOIN accessible a ON child.parent_id = a.id
+		), query AS (
+			SELECT to_tsquery('simple', @query) AS q
+		)
+		SELECT bp.id, bp.title, bp.status, bp.company_id,
+			ts_rank(bp.search_vector, query.q) AS rank,
+			ts_headline('simple', bp.title, query.q, @options) AS title_highlight,
+			ts_headline('simple', coalesce(bp.description, ''), query.q, @options) AS description_highlight
+		FROM base_projects bp, query
+		WHERE bp.search_vector @@ query.q
+			AND bp.id IN (SELECT id FROM accessible)`+tagFilter+`
+		ORDER BY rank DESC, bp.id
+		LIMIT @limit`,
+		map[string]interface{}{"user": userID, "query": tsQuery, "options": headlineOptions, "limit": limit, "tags": names, "tagCount": len(names)}).
+		Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// searchCompanies returns companies the user is an active member of
+func (s *SearchService) searchCompanies(tsQuery, userID string, limit int) ([]CompanyHit, error) {
+	var hits []CompanyHit
+	err := s.database.Raw(`
+		WITH query AS (
+			SELECT to_tsquery('simple', @query) AS q
+		)
+		SELECT c.id, c.name, c.type,
+			ts_rank(c.search_vector, query.q) AS rank,
+			ts_headline('simple', c.name, query.q, @options) AS name_highlight
+		FROM companies c, query
+		WHERE c.search_vector @@ query.q
+			AND EXISTS (
+				SELECT 1 FROM company_members cm
+				WHERE cm.company_id = c.id AND cm.user_id = @user AND cm.status = 'active'
+			)
+		ORDER BY rank DESC, c.id
+		LIMIT @limit`,
+		map[string]interface{}{"user": userID, "query": tsQuery, "options": headlineOptions, "limit": limit}).
+		Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// searchMembers returns members of companies the user can see members of
+func (s *SearchService) searchMembers(tsQuery, userID string, limit int) ([]MemberHit, error) {
+	var hits []MemberHit
+	err := s.database.Raw(`
+		WITH query AS (
+			SELECT to_tsquery('simple', @query) AS q
+		)
+		SELECT m.company_id, m.user_id, m.role,
+			ts_rank(m.search_vector, query.q) AS rank,
+			ts_headline('simple', m.user_id, query.q, @options) AS user_id_highlight
+		FROM company_members m, query
+		WHERE m.search_vector @@ query.q
+			AND EXISTS (
+				SELECT 1 FROM company_members cm
+				WHERE cm.company_id = m.company_id AND cm.user_id = @user AND cm.status = 'active'
+			)
+		ORDER BY rank DESC, m.company_id, m.user_id
+		LIMIT @limit`,
+		map[string]interface{}{"user": userID, "query": tsQuery, "options": headlineOptions, "limit": limit}).
+		Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// tagNames lowercases and deduplicates tag names, dropping blank ones
+func tagNames(tags []string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, tag := range tags {
+		name := strings.ToLower(strings.TrimSpace(tag))
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// prefixQuery turns free text into a tsquery where every word must match as a
+// prefix, e.g. "web redes" becomes "web:* & redes:*". Only letters and digits
+// survive, so user input can never inject tsquery operators.
+func prefixQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > MaxTerms {
+		words = words[:MaxTerms]
+	}
+
+	terms := make([]string, len(words))
+	for i, word := range words {
+		terms[i] = word + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,371 @@
+package tags
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/pgconnect"
+	"gorm.io/gorm"
+)
+
+// DefaultColor is used for tags created without a color
+const DefaultColor = "#9e9e9e"
+
+// MaxNameLength caps tag names, in characters
+const MaxNameLength = 50
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type TagService struct {
+	database          *pgconnect.DB
+	projectService    *projects.ProjectService
+	tagRepo           *pgconnect.Repository[db.Tag]
+	projectTagRepo    *pgconnect.Repository[db.ProjectTag]
+	companyRepo       *pgconnect.Repository[db.Company]
+	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+}
+
+func NewTagService(database *pgconnect.DB, projectService *projects.ProjectService) *TagService {
+	return &TagService{
+		database:          database,
+		projectService:    projectService,
+		tagRepo:           pgconnect.NewRepository[db.Tag](database),
+		projectTagRepo:    pgconnect.NewRepository[db.ProjectTag](database),
+		companyRepo:       pgconnect.NewRepository[db.Company](database),
+		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+	}
+}
+
+// CreateTag adds a company tag, for company managers, or a personal tag of
+// userID when the tag has no company
+func (s *TagService) CreateTag(tag *db.Tag, userID string) (*db.Tag, error) {
+	if err := normalizeTag(tag); err != nil {
+		return nil, err
+	}
+	if tag.CompanyID != nil {
+		if err := s.checkCanManageCompanyTags(userID, *tag.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+	tag.OwnerID = userID
+
+	if err := s.checkNameAvailable(tag); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tag.CreatedAt = now
+	tag.UpdatedAt = now
+	if err := s.tagRepo.Create(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// GetTags lists the tags of a company for its members, or the user's personal
+// tags when companyID is nil, ordered by name
+func (s *TagService) GetTags(companyID *string, userID string) ([]db.Tag, error) {
+	query := s.database.Where("company_id IS NULL AND owner_id = ?", userID)
+	if companyID != nil {
+		canAccess, err := s.userCanAccessCompany(userID, *companyID)
+		if err != nil {
+			return nil, err
+		}
+		if !canAccess {
+			return nil, errors.New("user cannot access this company")
+		}
+		query = s.database.Where("company_id = ?", *companyID)
+	}
+
+	var tags []db.Tag
+	if err := query.Order("LOWER(name)").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// UpdateTag renames or recolors a tag. An empty name or color keeps the
+// current value. Renaming to the name of another tag fails; merge them instead.
+func (s *TagService) UpdateTag(id uint, name, color, userID string) (*db.Tag, error) {
+	tag, err := s.getManageableTag(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != "" {
+		tag.Name = name
+	}
+	if color != "" {
+		tag.Color = color
+	}
+	if err := normalizeTag(tag); err != nil {
+		return nil, err
+	}
+	if err := s.checkNameAvailable(tag); err != nil {
+		return nil, err
+	}
+
+	tag.UpdatedAt = time.Now()
+	if err := s.tagRepo.Update(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// MergeTags moves every project tagged with source to target and deletes
+// source. Both tags must belong to the same company, or both be personal tags
+// of the user.
+func (s *TagService) MergeTags(sourceID, targetID uint, userID string) (*db.Tag, error) {
+	if sourceID == targetID {
+		return nil, errors.New("cannot merge a tag into itself")
+	}
+	source, err := s.getManageableTag(sourceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.getManageableTag(targetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !sameCompany(source.CompanyID, target.CompanyID) {
+		return nil, errors.New("tags must belong to the same company or both be personal")
+	}
+
+	err = s.database.WithTransaction(func(tx *gorm.DB) error {
+		// Projects that already have the target keep their original tagging
+		if err := tx.Exec(`
+			INSERT INTO project_tags (project_id, tag_id, tagged_by, tagged_at)
+			SELECT project_id, ?, tagged_by, tagged_at FROM project_tags WHERE tag_id = ?
+			ON CONFLICT DO NOTHING`, target.ID, source.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id = ?", source.ID).Delete(&db.ProjectTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(source).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// DeleteTag removes a tag from every project and deletes it
+func (s *TagService) DeleteTag(id uint, userID string) error {
+	tag, err := s.getManageableTag(id, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.database.WithTransaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", tag.ID).Delete(&db.ProjectTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(tag).Error
+	})
+}
+
+// Project tags
+
+// GetProjectTags lists the project's company tags and the user's own
+// personal tags on it, ordered by name
+func (s *TagService) GetProjectTags(projectID uint, userID string) ([]db.Tag, error) {
+	if _, err := s.projectService.GetProject(projectID, userID); err != nil {
+		return nil, err
+	}
+
+	var tags []db.Tag
+	err := s.database.
+		Joins("JOIN project_tags ON project_tags.tag_id = tags.id").
+		Where("project_tags.project_id = ? AND (tags.company_id IS NOT NULL OR tags.owner_id = ?)", projectID, userID).
+		Order("LOWER(tags.name)").
+		Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// TagProject applies a tag. Company tags only go on the company's projects
+// and need permission to update the project; personal tags go on any project
+// the user can access.
+func (s *TagService) TagProject(projectID, tagID uint, userID string) (*db.ProjectTag, error) {
+	if _, err := s.checkCanTag(projectID, tagID, userID); err != nil {
+		return nil, err
+	}
+
+	var existing db.ProjectTag
+	err := s.projectTagRepo.FindOne(&existing, "project_id = ? AND tag_id = ?", projectID, tagID)
+	if err == nil {
+		return nil, errors.New("project already has this tag")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	projectTag := &db.ProjectTag{
+		ProjectID: projectID,
+		TagID:     tagID,
+		TaggedBy:  userID,
+		TaggedAt:  time.Now(),
+	}
+	if err := s.projectTagRepo.Create(projectTag); err != nil {
+		return nil, err
+	}
+	return projectTag, nil
+}
+
+func (s *TagService) UntagProject(projectID, tagID uint, userID string) error {
+	if _, err := s.checkCanTag(projectID, tagID, userID); err != nil {
+		return err
+	}
+
+	result := s.database.Where("project_id = ? AND tag_id = ?", projectID, tagID).Delete(&db.ProjectTag{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("project does not have this tag")
+	}
+	return nil
+}
+
+// Private helper methods
+
+// getTag loads a tag the user can see; other users' personal tags are reported
+// as missing
+func (s *TagService) getTag(id uint, userID string) (*db.Tag, error) {
+	var tag db.Tag
+	if err := s.tagRepo.FindByID(id, &tag); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("tag not found")
+		}
+		return nil, err
+	}
+	if tag.CompanyID == nil && tag.OwnerID != userID {
+		return nil, errors.New("tag not found")
+	}
+	return &tag, nil
+}
+
+func (s *TagService) getManageableTag(id uint, userID string) (*db.Tag, error) {
+	tag, err := s.getTag(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tag.CompanyID != nil {
+		if err := s.checkCanManageCompanyTags(userID, *tag.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+	return tag, nil
+}
+
+func (s *TagService) checkCanTag(projectID, tagID uint, userID string) (*db.Tag, error) {
+	project, err := s.projectService.GetProject(projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := s.getTag(tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tag.CompanyID == nil {
+		return tag, nil
+	}
+
+	if project.CompanyID == nil || *project.CompanyID != *tag.CompanyID {
+		return nil, errors.New("tag belongs to another company")
+	}
+	canUpdate, err := s.projectService.CanUpdateProject(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !canUpdate {
+		return nil, errors.New("user cannot update this project")
+	}
+	return tag, nil
+}
+
+// checkCanManageCompanyTags allows the company owner and roles with the update
+// permission
+func (s *TagService) checkCanManageCompanyTags(userID, companyID string) error {
+	allowed, err := companies.HasPermission(s.database, companyID, userID, companies.UpdatePermission)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New("company not found")
+	}
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errors.New("user cannot manage tags in this company")
+	}
+	return nil
+}
+
+func (s *TagService) userCanAccessCompany(userID, companyID string) (bool, error) {
+	var company db.Company
+	if err := s.companyRepo.FindByID(companyID, &company); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, errors.New("company not found")
+		}
+		return false, err
+	}
+	if company.OwnerID == userID {
+		return true, nil
+	}
+
+	var member db.CompanyMember
+	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
+	return err == nil, nil
+}
+
+// checkNameAvailable keeps names unique, ignoring case, among a company's tags
+// or a user's personal tags
+func (s *TagService) checkNameAvailable(tag *db.Tag) error {
+	query := s.database.Model(&db.Tag{}).Where("LOWER(name) = LOWER(?) AND id <> ?", tag.Name, tag.ID)
+	if tag.CompanyID != nil {
+		query = query.Where("company_id = ?", *tag.CompanyID)
+	} else {
+		query = query.Where("company_id IS NULL AND owner_id = ?", tag.OwnerID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("tag name already exists")
+	}
+	return nil
+}
+
+// normalizeTag trims the name and defaults the color. Names cannot contain
+// commas, which separate tags in query strings.
+func normalizeTag(tag *db.Tag) error {
+	tag.Name = strings.TrimSpace(tag.Name)
+	if tag.Name == "" || utf8.RuneCountInString(tag.Name) > MaxNameLength || strings.Contains(tag.Name, ",") {
+		return errors.New("invalid tag name")
+	}
+	if tag.Color == "" {
+		tag.Color = DefaultColor
+	}
+	if !colorPattern.MatchString(tag.Color) {
+		return errors.New("invalid tag color")
+	}
+	tag.Color = strings.ToLower(tag.Color)
+	return nil
+}
+
+func sameCompany(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
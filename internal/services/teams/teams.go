@@ -0,0 +1,270 @@
+package teams
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/events"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+var log = slog.Default().With(
+	slog.String("layer", "service"),
+	slog.String("service", "TeamService"),
+)
+
+type TeamService struct {
+	database          *pgconnect.DB
+	eventService      *events.EventService
+	teamRepo          *pgconnect.Repository[db.Team]
+	teamMemberRepo    *pgconnect.Repository[db.TeamMember]
+	projectTeamRepo   *pgconnect.Repository[db.ProjectTeam]
+	companyMemberRepo *pgconnect.Repository[db.CompanyMember]
+}
+
+func NewTeamService(database *pgconnect.DB, eventService *events.EventService) *TeamService {
+	return &TeamService{
+		database:          database,
+		eventService:      eventService,
+		teamRepo:          pgconnect.NewRepository[db.Team](database),
+		teamMemberRepo:    pgconnect.NewRepository[db.TeamMember](database),
+		projectTeamRepo:   pgconnect.NewRepository[db.ProjectTeam](database),
+		companyMemberRepo: pgconnect.NewRepository[db.CompanyMember](database),
+	}
+}
+
+func (s *TeamService) CreateTeam(team *db.Team, requestingUserID string) (*db.Team, error) {
+	if team.CompanyID == "" {
+		return nil, errors.New("company ID is required")
+	}
+
+	canManage, err := s.userCanManageTeams(requestingUserID, team.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("user cannot manage teams in this company")
+	}
+
+	// Team names are unique within a company
+	var existing db.Team
+	if err := s.teamRepo.FindOne(&existing, "company_id = ? AND name = ?", team.CompanyID, team.Name); err == nil {
+		return nil, errors.New("team name already exists in this company")
+	}
+
+	now := time.Now()
+	team.CreatedBy = requestingUserID
+	team.CreatedAt = now
+	team.UpdatedAt = now
+
+	if err := s.teamRepo.Create(team); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+func (s *TeamService) GetTeam(id uint, userID string) (*db.Team, error) {
+	var team db.Team
+	if err := s.teamRepo.FindByID(id, &team); err != nil {
+		return nil, err
+	}
+
+	if !s.userIsActiveCompanyMember(userID, team.CompanyID) {
+		return nil, errors.New("user cannot access this team")
+	}
+
+	return &team, nil
+}
+
+func (s *TeamService) UpdateTeam(id uint, updates *db.Team, userID string) (*db.Team, error) {
+	var team db.Team
+	if err := s.teamRepo.FindByID(id, &team); err != nil {
+		return nil, err
+	}
+
+	canManage, err := s.userCanManageTeams(userID, team.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("user cannot manage teams in this company")
+	}
+
+	if updates.Name != "" && updates.Name != team.Name {
+		var existing db.Team
+		if err := s.teamRepo.FindOne(&existing, "company_id = ? AND name = ?", team.CompanyID, updates.Name); err == nil {
+			return nil, errors.New("team name already exists in this company")
+		}
+		team.Name = updates.Name
+	}
+	if updates.Description != nil {
+		team.Description = updates.Description
+	}
+	team.UpdatedAt = time.Now()
+
+	if err := s.teamRepo.Update(&team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+func (s *TeamService) DeleteTeam(id uint, userID string) error {
+	var team db.Team
+	if err := s.teamRepo.FindByID(id, &team); err != nil {
+		return err
+	}
+
+	canManage, err := s.userCanManageTeams(userID, team.CompanyID)
+	if err != nil {
+		return err
+	}
+	if !canManage {
+		return errors.New("user cannot manage teams in this company")
+	}
+
+	var members []db.TeamMember
+	if err := s.teamMemberRepo.FindWhere(&members, "team_id = ?", id); err != nil {
+		return err
+	}
+
+	// Remove project grants and memberships before the team itself
+	if err := s.projectTeamRepo.DeleteWhere("team_id = ?", id); err != nil {
+		return err
+	}
+	if err := s.teamMemberRepo.DeleteWhere("team_id = ?", id); err != nil {
+		return err
+	}
+	if err := s.teamRepo.Delete(&team); err != nil {
+		return err
+	}
+
+	// Every member loses the projects the team was granted
+	for i := range members {
+		s.recordEvent(events.TeamMemberEvent(events.ActionTeamMemberRemoved, &team, &members[i], userID))
+	}
+	return nil
+}
+
+func (s *TeamService) GetCompanyTeams(companyID, userID string) ([]db.Team, error) {
+	if !s.userIsActiveCompanyMember(userID, companyID) {
+		return nil, errors.New("user cannot access this company")
+	}
+
+	var teams []db.Team
+	if err := s.teamRepo.FindWhere(&teams, "company_id = ?", companyID); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func (s *TeamService) AddTeamMember(teamID uint, userID, requestingUserID string) (*db.TeamMember, error) {
+	var team db.Team
+	if err := s.teamRepo.FindByID(teamID, &team); err != nil {
+		return nil, err
+	}
+
+	canManage, err := s.userCanManageTeams(requestingUserID, team.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, errors.New("user cannot manage teams in this company")
+	}
+
+	// Business rule: only active company members can join a team
+	var companyMember db.CompanyMember
+	err = s.companyMemberRepo.FindOne(&companyMember, "company_id = ? AND user_id = ? AND status = ?", team.CompanyID, userID, "active")
+	if err != nil {
+		return nil, errors.New("user is not an active member of this company")
+	}
+
+	var existing db.TeamMember
+	if err := s.teamMemberRepo.FindOne(&existing, "team_id = ? AND user_id = ?", teamID, userID); err == nil {
+		return nil, errors.New("user is already a member of this team")
+	}
+
+	member := &db.TeamMember{
+		TeamID:          teamID,
+		CompanyMemberID: companyMember.ID,
+		UserID:          userID,
+		JoinedAt:        time.Now(),
+	}
+
+	if err := s.teamMemberRepo.Create(member); err != nil {
+		return nil, err
+	}
+	s.recordEvent(events.TeamMemberEvent(events.ActionTeamMemberAdded, &team, member, requestingUserID))
+
+	return member, nil
+}
+
+func (s *TeamService) RemoveTeamMember(teamID uint, userID, requestingUserID string) error {
+	var team db.Team
+	if err := s.teamRepo.FindByID(teamID, &team); err != nil {
+		return err
+	}
+
+	canManage, err := s.userCanManageTeams(requestingUserID, team.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	// Users can leave a team themselves
+	isSelfRemoval := userID == requestingUserID
+	if !canManage && !isSelfRemoval {
+		return errors.New("user cannot manage teams in this company")
+	}
+
+	if err := s.teamMemberRepo.DeleteWhere("team_id = ? AND user_id = ?", teamID, userID); err != nil {
+		return err
+	}
+	s.recordEvent(events.TeamMemberEvent(events.ActionTeamMemberRemoved, &team, &db.TeamMember{TeamID: teamID, UserID: userID}, requestingUserID))
+	return nil
+}
+
+func (s *TeamService) GetTeamMembers(teamID uint, requestingUserID string) ([]db.TeamMember, error) {
+	var team db.Team
+	if err := s.teamRepo.FindByID(teamID, &team); err != nil {
+		return nil, err
+	}
+
+	if !s.userIsActiveCompanyMember(requestingUserID, team.CompanyID) {
+		return nil, errors.New("user cannot access this team")
+	}
+
+	var members []db.TeamMember
+	if err := s.teamMemberRepo.FindWhere(&members, "team_id = ?", teamID); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// Private helper methods
+
+func (s *TeamService) userIsActiveCompanyMember(userID, companyID string) bool {
+	var member db.CompanyMember
+	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", companyID, userID, "active")
+	return err == nil
+}
+
+func (s *TeamService) recordEvent(event db.ChangeEvent) {
+	if s.eventService == nil {
+		return
+	}
+	if err := s.eventService.Record(&event); err != nil {
+		log.Error("record-event:failed", "action", event.Action, "error", err)
+	}
+}
+
+// userCanManageTeams follows company membership: the owner and roles with the
+// manage_members permission
+func (s *TeamService) userCanManageTeams(userID, companyID string) (bool, error) {
+	return companies.HasPermission(s.database, companyID, userID, companies.ManageMembersPermission)
+}
@@ -0,0 +1,265 @@
+package templates
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/go-project-manager/internal/db"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/companies"
+	"github.com/JorgeSaicoski/go-project-manager/internal/services/projects"
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+type TemplateService struct {
+	database           *pgconnect.DB
+	templateRepo       *pgconnect.Repository[db.ProjectTemplate]
+	templateMemberRepo *pgconnect.Repository[db.ProjectTemplateMember]
+	projectMemberRepo  *pgconnect.Repository[db.ProjectMember]
+	companyMemberRepo  *pgconnect.Repository[db.CompanyMember]
+	projectService     *projects.ProjectService
+}
+
+func NewTemplateService(database *pgconnect.DB, projectService *projects.ProjectService) *TemplateService {
+	return &TemplateService{
+		database:           database,
+		templateRepo:       pgconnect.NewRepository[db.ProjectTemplate](database),
+		templateMemberRepo: pgconnect.NewRepository[db.ProjectTemplateMember](database),
+		projectMemberRepo:  pgconnect.NewRepository[db.ProjectMember](database),
+		companyMemberRepo:  pgconnect.NewRepository[db.CompanyMember](database),
+		projectService:     projectService,
+	}
+}
+
+// InstantiateOptions controls how a project is created from a template
+type InstantiateOptions struct {
+	Title     string     // Defaults to the template title
+	StartDate *time.Time // EndDate is computed from the template duration
+	OwnerID   string
+	CompanyID *string // Defaults to the template company
+}
+
+func (s *TemplateService) CreateTemplate(template *db.ProjectTemplate, requestingUserID string) (*db.ProjectTemplate, error) {
+	if template.CompanyID != nil {
+		canManage := s.userCanManageCompanyTemplates(requestingUserID, *template.CompanyID)
+		if !canManage {
+			return nil, errors.New("user cannot manage templates in this company")
+		}
+	}
+
+	now := time.Now()
+	template.OwnerID = requestingUserID
+	template.CreatedAt = now
+	template.UpdatedAt = now
+
+	// Members are saved together with the template
+	if err := s.templateRepo.Create(template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *TemplateService) GetTemplate(id uint, userID string) (*db.ProjectTemplate, error) {
+	template, err := s.findTemplate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.userCanUseTemplate(userID, template) {
+		return nil, errors.New("user cannot access this template")
+	}
+
+	return template, nil
+}
+
+func (s *TemplateService) UpdateTemplate(id uint, updates *db.ProjectTemplate, userID string) (*db.ProjectTemplate, error) {
+	template, err := s.findTemplate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.userCanManageTemplate(userID, template) {
+		return nil, errors.New("user cannot update this template")
+	}
+
+	// Update fields
+	if updates.Name != "" {
+		template.Name = updates.Name
+	}
+	if updates.Title != "" {
+		template.Title = updates.Title
+	}
+	if updates.Description != nil {
+		template.Description = updates.Description
+	}
+	if updates.DurationDays != nil {
+		template.DurationDays = updates.DurationDays
+	}
+	template.UpdatedAt = time.Now()
+
+	// A non-nil member list replaces the existing one
+	if updates.Members != nil {
+		if err := s.templateMemberRepo.DeleteWhere("template_id = ?", id); err != nil {
+			return nil, err
+		}
+		for i := range updates.Members {
+			updates.Members[i].ID = 0
+			updates.Members[i].TemplateID = id
+		}
+		template.Members = updates.Members
+	}
+
+	if err := s.templateRepo.Update(template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *TemplateService) DeleteTemplate(id uint, userID string) error {
+	template, err := s.findTemplate(id)
+	if err != nil {
+		return err
+	}
+
+	if !s.userCanManageTemplate(userID, template) {
+		return errors.New("user cannot delete this template")
+	}
+
+	if err := s.templateMemberRepo.DeleteWhere("template_id = ?", id); err != nil {
+		return err
+	}
+
+	return s.templateRepo.Delete(template)
+}
+
+func (s *TemplateService) GetUserTemplates(userID string) ([]db.ProjectTemplate, error) {
+	// Personal templates
+	var templates []db.ProjectTemplate
+	if err := s.templateRepo.FindWhere(&templates, "owner_id = ? AND company_id IS NULL", userID); err != nil {
+		return nil, err
+	}
+
+	// Templates of companies where the user is an active member
+	var members []db.CompanyMember
+	if err := s.companyMemberRepo.FindWhere(&members, "user_id = ? AND status = ?", userID, "active"); err != nil {
+		return nil, err
+	}
+	if len(members) > 0 {
+		companyIDs := make([]string, len(members))
+		for i, member := range members {
+			companyIDs[i] = member.CompanyID
+		}
+
+		var companyTemplates []db.ProjectTemplate
+		if err := s.templateRepo.FindWhere(&companyTemplates, "company_id IN ?", companyIDs); err != nil {
+			return nil, err
+		}
+		templates = append(templates, companyTemplates...)
+	}
+
+	for i := range templates {
+		if err := s.templateMemberRepo.FindWhere(&templates[i].Members, "template_id = ?", templates[i].ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+// CreateProjectFromTemplate creates a project and its members from a template.
+// The project is created through ProjectService so the usual company rules apply.
+func (s *TemplateService) CreateProjectFromTemplate(id uint, options InstantiateOptions) (*db.BaseProject, []db.ProjectMember, error) {
+	template, err := s.GetTemplate(id, options.OwnerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := &db.BaseProject{
+		Title:       template.Title,
+		Description: template.Description,
+		Status:      "active",
+		OwnerID:     options.OwnerID,
+		CompanyID:   template.CompanyID,
+		StartDate:   options.StartDate,
+	}
+	if options.Title != "" {
+		project.Title = options.Title
+	}
+	if options.CompanyID != nil {
+		project.CompanyID = options.CompanyID
+	}
+	if options.StartDate != nil && template.DurationDays != nil {
+		endDate := options.StartDate.AddDate(0, 0, *template.DurationDays)
+		project.EndDate = &endDate
+	}
+
+	if _, err := s.projectService.CreateProject(project); err != nil {
+		return nil, nil, err
+	}
+
+	members := make([]db.ProjectMember, 0, len(template.Members))
+	for _, templateMember := range template.Members {
+		// The owner already has full access
+		if templateMember.UserID == options.OwnerID {
+			continue
+		}
+
+		member, err := s.projectService.AddProjectMember(
+			project.ID,
+			templateMember.UserID,
+			templateMember.Role,
+			templateMember.Permissions,
+			options.OwnerID,
+		)
+		if err != nil {
+			// Rollback project creation if a member cannot be added
+			s.projectMemberRepo.DeleteWhere("project_id = ? AND project_type = ?", strconv.Itoa(int(project.ID)), "core")
+			s.projectService.DeleteProject(project.ID, options.OwnerID)
+			return nil, nil, errors.New("failed to add template member: " + err.Error())
+		}
+		members = append(members, *member)
+	}
+
+	return project, members, nil
+}
+
+// Private helper methods
+
+func (s *TemplateService) findTemplate(id uint) (*db.ProjectTemplate, error) {
+	var template db.ProjectTemplate
+	if err := s.templateRepo.FindByID(id, &template); err != nil {
+		return nil, err
+	}
+	if err := s.templateMemberRepo.FindWhere(&template.Members, "template_id = ?", id); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (s *TemplateService) userCanUseTemplate(userID string, template *db.ProjectTemplate) bool {
+	if template.CompanyID == nil {
+		return template.OwnerID == userID
+	}
+
+	var member db.CompanyMember
+	err := s.companyMemberRepo.FindOne(&member, "company_id = ? AND user_id = ? AND status = ?", *template.CompanyID, userID, "active")
+	return err == nil
+}
+
+func (s *TemplateService) userCanManageTemplate(userID string, template *db.ProjectTemplate) bool {
+	if template.OwnerID == userID {
+		return true
+	}
+	if template.CompanyID == nil {
+		return false
+	}
+	return s.userCanManageCompanyTemplates(userID, *template.CompanyID)
+}
+
+func (s *TemplateService) userCanManageCompanyTemplates(userID, companyID string) bool {
+	// Business rule: same roles that can create company projects
+	allowed, err := companies.HasPermission(s.database, companyID, userID, companies.CreateProjectsPermission)
+	return err == nil && allowed
+}